@@ -2,25 +2,28 @@
 package assets
 
 import (
-	"bytes"
-	"image/png"
+	"strconv"
 
 	"github.com/golang/freetype/truetype"
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/audio"
 	"github.com/hajimehoshi/ebiten/audio/mp3"
-	"github.com/skatiyar/pacman/assets/fonts"
 	"github.com/skatiyar/pacman/assets/images"
 	"github.com/skatiyar/pacman/assets/sounds"
-	"github.com/skatiyar/pacman/spritetools"
 )
 
 type Characters struct {
-	Pacman *ebiten.Image
-	Ghost1 *ebiten.Image
-	Ghost2 *ebiten.Image
-	Ghost3 *ebiten.Image
-	Ghost4 *ebiten.Image
+	// PacmanChomp are frames of pacman closing its mouth.
+	PacmanChomp []*ebiten.Image
+	// PacmanDeath are frames of pacman vanishing.
+	PacmanDeath []*ebiten.Image
+	// GhostWobble are frames of each ghost's body,
+	// in order Ghost1, Ghost2, Ghost3 & Ghost4.
+	GhostWobble [4][]*ebiten.Image
+	// Frightened are frames of a frightened ghost, Blinking
+	// of one whose invincibility is about to end.
+	Frightened []*ebiten.Image
+	Blinking   []*ebiten.Image
 }
 
 type Powers struct {
@@ -41,125 +44,71 @@ type Assets struct {
 	Characters *Characters
 	Powers     *Powers
 	Walls      *Walls
+	Palette    *Palette
 }
 
 // LoadAssets converts the character images(png, jpg, ...) to
-// ebiten image format and loads fonts.
+// ebiten image format and loads fonts, all of them embedded.
 func LoadAssets() (*Assets, error) {
-	skin, skinErr := loadSkin()
-	if skinErr != nil {
-		return nil, skinErr
-	}
-
-	font, fontErr := loadArcadeFont()
-	if fontErr != nil {
-		return nil, fontErr
-	}
-
-	characters, charactersErr := loadCharacters()
-	if charactersErr != nil {
-		return nil, charactersErr
-	}
-
-	powers, powersErr := loadPowers()
-	if powersErr != nil {
-		return nil, powersErr
-	}
-
-	walls, wallsErr := loadWalls()
-	if wallsErr != nil {
-		return nil, wallsErr
-	}
-
-	return &Assets{
-		ArcadeFont: font,
-		Skin:       skin,
-		Characters: characters,
-		Powers:     powers,
-		Walls:      walls,
-	}, nil
-}
-
-func loadSkin() (*ebiten.Image, error) {
-	sImage, sImageErr := png.Decode(bytes.NewReader(images.SkinPng))
-	if sImageErr != nil {
-		return nil, sImageErr
-	}
-
-	skin, skinErr := ebiten.NewImageFromImage(sImage, ebiten.FilterDefault)
-	if skinErr != nil {
-		return nil, skinErr
-	}
-
-	return skin, nil
-}
-
-func loadArcadeFont() (*truetype.Font, error) {
-	return truetype.Parse(fonts.ArcadeTTF)
+	return NewThemeLoader("").LoadAssets()
 }
 
-func loadCharacters() (*Characters, error) {
-	cImage, cImageErr := png.Decode(bytes.NewReader(images.CharactersPng))
-	if cImageErr != nil {
-		return nil, cImageErr
+func (t *ThemeLoader) loadCharacters() (*Characters, error) {
+	atlas, atlasErr := t.loadAtlas("characters", images.CharactersPng, images.CharactersJSON)
+	if atlasErr != nil {
+		return nil, atlasErr
 	}
 
-	characters, charactersErr := ebiten.NewImageFromImage(cImage, ebiten.FilterDefault)
-	if charactersErr != nil {
-		return nil, charactersErr
-	}
-
-	pacman, pacmanErr := spritetools.GetSprite(61, 64, 0, 0, characters)
-	if pacmanErr != nil {
-		return nil, pacmanErr
+	chomp, chompErr := atlas.Frames("pacman-chomp")
+	if chompErr != nil {
+		return nil, chompErr
 	}
 
-	ghost1, ghost1Err := spritetools.GetSprite(56, 64, 66, 0, characters)
-	if ghost1Err != nil {
-		return nil, ghost1Err
+	death, deathErr := atlas.Frames("pacman-death")
+	if deathErr != nil {
+		return nil, deathErr
 	}
 
-	ghost2, ghost2Err := spritetools.GetSprite(56, 64, 125, 0, characters)
-	if ghost2Err != nil {
-		return nil, ghost2Err
+	var wobble [4][]*ebiten.Image
+	for i := 0; i < len(wobble); i++ {
+		frames, framesErr := atlas.Frames("ghost" + strconv.Itoa(i+1) + "-wobble")
+		if framesErr != nil {
+			return nil, framesErr
+		}
+		wobble[i] = frames
 	}
 
-	ghost3, ghost3Err := spritetools.GetSprite(56, 64, 185, 0, characters)
-	if ghost3Err != nil {
-		return nil, ghost3Err
+	frightened, frightenedErr := atlas.Frames("frightened")
+	if frightenedErr != nil {
+		return nil, frightenedErr
 	}
 
-	ghost4, ghost4Err := spritetools.GetSprite(56, 64, 244, 0, characters)
-	if ghost4Err != nil {
-		return nil, ghost4Err
+	blinking, blinkingErr := atlas.Frames("blinking")
+	if blinkingErr != nil {
+		return nil, blinkingErr
 	}
 
 	return &Characters{
-		Pacman: pacman,
-		Ghost1: ghost1,
-		Ghost2: ghost2,
-		Ghost3: ghost3,
-		Ghost4: ghost4,
+		PacmanChomp: chomp,
+		PacmanDeath: death,
+		GhostWobble: wobble,
+		Frightened:  frightened,
+		Blinking:    blinking,
 	}, nil
 }
 
-func loadPowers() (*Powers, error) {
-	pImage, pImageErr := png.Decode(bytes.NewReader(images.PowersPng))
-	if pImageErr != nil {
-		return nil, pImageErr
-	}
-
-	powers, powersErr := ebiten.NewImageFromImage(pImage, ebiten.FilterDefault)
-	if powersErr != nil {
-		return nil, powersErr
+func (t *ThemeLoader) loadPowers() (*Powers, error) {
+	atlas, atlasErr := t.loadAtlas("powers", images.PowersPng, images.PowersJSON)
+	if atlasErr != nil {
+		return nil, atlasErr
 	}
 
-	life, lifeErr := spritetools.GetSprite(64, 64, 0, 0, powers)
+	life, lifeErr := atlas.Sprite("life")
 	if lifeErr != nil {
 		return nil, lifeErr
 	}
 
-	invinc, invincErr := spritetools.GetSprite(64, 64, 67, 0, powers)
+	invinc, invincErr := atlas.Sprite("invincibility")
 	if invincErr != nil {
 		return nil, invincErr
 	}
@@ -170,33 +119,28 @@ func loadPowers() (*Powers, error) {
 	}, nil
 }
 
-func loadWalls() (*Walls, error) {
-	wImage, wImageErr := png.Decode(bytes.NewReader(images.WallsPng))
-	if wImageErr != nil {
-		return nil, wImageErr
-	}
-
-	walls, wallsErr := ebiten.NewImageFromImage(wImage, ebiten.FilterDefault)
-	if wallsErr != nil {
-		return nil, wallsErr
+func (t *ThemeLoader) loadWalls() (*Walls, error) {
+	atlas, atlasErr := t.loadAtlas("walls", images.WallsPng, images.WallsJSON)
+	if atlasErr != nil {
+		return nil, atlasErr
 	}
 
-	inactiveCorner, inactiveCornerErr := spritetools.GetSprite(12, 12, 0, 0, walls)
+	inactiveCorner, inactiveCornerErr := atlas.Sprite("inactive-corner")
 	if inactiveCornerErr != nil {
 		return nil, inactiveCornerErr
 	}
 
-	inactiveSide, inactiveSideErr := spritetools.GetSprite(40, 12, 12, 0, walls)
+	inactiveSide, inactiveSideErr := atlas.Sprite("inactive-side")
 	if inactiveSideErr != nil {
 		return nil, inactiveSideErr
 	}
 
-	activeCorner, activeCornerErr := spritetools.GetSprite(12, 12, 52, 0, walls)
+	activeCorner, activeCornerErr := atlas.Sprite("active-corner")
 	if activeCornerErr != nil {
 		return nil, activeCornerErr
 	}
 
-	activeSide, activeSideErr := spritetools.GetSprite(40, 12, 64, 0, walls)
+	activeSide, activeSideErr := atlas.Sprite("active-side")
 	if activeSideErr != nil {
 		return nil, activeSideErr
 	}
@@ -221,32 +165,62 @@ type Sounds struct {
 // LoadSounds returns a struct with wav files decoded
 // for the provided audio context.
 func LoadSounds(ctx *audio.Context) (*Sounds, error) {
-	beginning, beginningErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(sounds.BeginningMp3))
+	return NewThemeLoader("").LoadSounds(ctx)
+}
+
+// LoadSounds returns sounds of theme decoded
+// for the provided audio context.
+func (t *ThemeLoader) LoadSounds(ctx *audio.Context) (*Sounds, error) {
+	beginningMp3, beginningMp3Err := t.file("beginning.mp3", sounds.BeginningMp3)
+	if beginningMp3Err != nil {
+		return nil, beginningMp3Err
+	}
+	beginning, beginningErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(beginningMp3))
 	if beginningErr != nil {
 		return nil, beginningErr
 	}
 
-	chomp, chompErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(sounds.ChompMp3))
+	chompMp3, chompMp3Err := t.file("chomp.mp3", sounds.ChompMp3)
+	if chompMp3Err != nil {
+		return nil, chompMp3Err
+	}
+	chomp, chompErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(chompMp3))
 	if chompErr != nil {
 		return nil, chompErr
 	}
 
-	death, deathErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(sounds.DeathMp3))
+	deathMp3, deathMp3Err := t.file("death.mp3", sounds.DeathMp3)
+	if deathMp3Err != nil {
+		return nil, deathMp3Err
+	}
+	death, deathErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(deathMp3))
 	if deathErr != nil {
 		return nil, deathErr
 	}
 
-	eatFlask, eatFlaskErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(sounds.EatFlaskMp3))
+	eatFlaskMp3, eatFlaskMp3Err := t.file("eatflask.mp3", sounds.EatFlaskMp3)
+	if eatFlaskMp3Err != nil {
+		return nil, eatFlaskMp3Err
+	}
+	eatFlask, eatFlaskErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(eatFlaskMp3))
 	if eatFlaskErr != nil {
 		return nil, eatFlaskErr
 	}
 
-	eatGhost, eatGhostErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(sounds.EatGhostMp3))
+	eatGhostMp3, eatGhostMp3Err := t.file("eatghost.mp3", sounds.EatGhostMp3)
+	if eatGhostMp3Err != nil {
+		return nil, eatGhostMp3Err
+	}
+	eatGhost, eatGhostErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(eatGhostMp3))
 	if eatGhostErr != nil {
 		return nil, eatGhostErr
 	}
 
-	extraPac, extraPacErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(sounds.ExtraPacMp3))
+	extraPacMp3, extraPacMp3Err := t.file("extrapac.mp3", sounds.ExtraPacMp3)
+	if extraPacMp3Err != nil {
+		return nil, extraPacMp3Err
+	}
+	extraPac, extraPacErr := mp3.Decode(ctx, audio.BytesReadSeekCloser(extraPacMp3))
 	if extraPacErr != nil {
 		return nil, extraPacErr
 	}
@@ -0,0 +1,136 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/skatiyar/pacman/spritetools"
+)
+
+// Rect is a rectangle of a sprite sheet, in pixels.
+type Rect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// Manifest names frames of a sprite sheet, every name holds
+// rectangles of its frames in order, a single one for sprites
+// which are not animated.
+type Manifest map[string][]Rect
+
+// ParseManifest reads a manifest encoded as JSON, it
+// rejects names without frames & empty rectangles.
+func ParseManifest(data []byte) (Manifest, error) {
+	manifest := make(Manifest)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if decodeErr := decoder.Decode(&manifest); decodeErr != nil {
+		return nil, fmt.Errorf("atlas: %v", decodeErr)
+	}
+	for _, name := range manifest.Names() {
+		rects := manifest[name]
+		if len(rects) == 0 {
+			return nil, fmt.Errorf("atlas: %q has no frames", name)
+		}
+		for i, rect := range rects {
+			if rect.W <= 0 || rect.H <= 0 || rect.X < 0 || rect.Y < 0 {
+				return nil, fmt.Errorf("atlas: frame %d of %q is not a valid rectangle, got %+v", i, name, rect)
+			}
+		}
+	}
+	return manifest, nil
+}
+
+// Names returns names of frames, sorted.
+func (m Manifest) Names() []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Fits checks that manifest names every frame of defaults, at
+// same size, so its sprites can take place of default ones.
+// Number of frames of a name may differ, down to one.
+func (m Manifest) Fits(defaults Manifest) error {
+	for _, name := range defaults.Names() {
+		rects, ok := m[name]
+		if !ok {
+			return fmt.Errorf("atlas: %q is missing", name)
+		}
+		want := defaults[name][0]
+		for i, rect := range rects {
+			if rect.W != want.W || rect.H != want.H {
+				return fmt.Errorf("atlas: frame %d of %q is %dx%d, want %dx%d",
+					i, name, rect.W, rect.H, want.W, want.H)
+			}
+		}
+	}
+	return nil
+}
+
+// Atlas holds frames cut from a sprite sheet, by name.
+type Atlas struct {
+	frames map[string][]*ebiten.Image
+}
+
+// LoadAtlas decodes a png sprite sheet & cuts it into
+// frames named by manifest, frames must lie within sheet.
+func LoadAtlas(sheet []byte, rects Manifest) (*Atlas, error) {
+	sImage, sImageErr := png.Decode(bytes.NewReader(sheet))
+	if sImageErr != nil {
+		return nil, sImageErr
+	}
+
+	src, srcErr := ebiten.NewImageFromImage(sImage, ebiten.FilterDefault)
+	if srcErr != nil {
+		return nil, srcErr
+	}
+
+	bounds := sImage.Bounds()
+	atlas := &Atlas{frames: make(map[string][]*ebiten.Image, len(rects))}
+	for _, name := range rects.Names() {
+		frames := make([]*ebiten.Image, len(rects[name]))
+		for i, rect := range rects[name] {
+			if !image.Rect(rect.X, rect.Y, rect.X+rect.W, rect.Y+rect.H).In(bounds) {
+				return nil, fmt.Errorf("atlas: frame %d of %q lies outside sheet of size %dx%d",
+					i, name, bounds.Dx(), bounds.Dy())
+			}
+			frame, frameErr := spritetools.GetSprite(rect.W, rect.H, rect.X, rect.Y, src)
+			if frameErr != nil {
+				return nil, frameErr
+			}
+			frames[i] = frame
+		}
+		atlas.frames[name] = frames
+	}
+
+	return atlas, nil
+}
+
+// Frames returns frames of given name.
+func (a *Atlas) Frames(name string) ([]*ebiten.Image, error) {
+	frames, ok := a.frames[name]
+	if !ok {
+		return nil, fmt.Errorf("atlas: no frames named %q", name)
+	}
+	return frames, nil
+}
+
+// Sprite returns first frame of given name.
+func (a *Atlas) Sprite(name string) (*ebiten.Image, error) {
+	frames, framesErr := a.Frames(name)
+	if framesErr != nil {
+		return nil, framesErr
+	}
+	return frames[0], nil
+}
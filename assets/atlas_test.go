@@ -0,0 +1,67 @@
+package assets
+
+import (
+	"testing"
+
+	"github.com/skatiyar/pacman/assets/images"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseManifest(t *testing.T) {
+	manifest, parseErr := ParseManifest([]byte(`{
+		"pacman": [{"x": 0, "y": 0, "w": 61, "h": 64}],
+		"chomp": [{"x": 0, "y": 65, "w": 64, "h": 64}, {"x": 64, "y": 65, "w": 64, "h": 64}]
+	}`))
+	assert.Nil(t, parseErr, "Should be nil")
+	assert.Equal(t, []string{"chomp", "pacman"}, manifest.Names(), "Names should be sorted")
+	assert.Equal(t, Rect{X: 64, Y: 65, W: 64, H: 64}, manifest["chomp"][1], "Frames should keep order")
+}
+
+func TestParseManifestInvalid(t *testing.T) {
+	cases := map[string]string{
+		`{"pacman": []}`: `atlas: "pacman" has no frames`,
+		`{"pacman": [{"x": 0, "y": 0, "w": 0, "h": 64}]}`: `atlas: frame 0 of "pacman" is not a valid rectangle, got {X:0 Y:0 W:0 H:64}`,
+		`{"pacman": [{"x": 0, "y": 0, "width": 61}]}`:     `atlas: json: unknown field "width"`,
+	}
+	for manifest, message := range cases {
+		_, parseErr := ParseManifest([]byte(manifest))
+		if assert.NotNil(t, parseErr, manifest) {
+			assert.Equal(t, message, parseErr.Error(), manifest)
+		}
+	}
+}
+
+func TestManifests(t *testing.T) {
+	for _, manifest := range [][]byte{images.CharactersJSON, images.PowersJSON, images.WallsJSON} {
+		_, parseErr := ParseManifest(manifest)
+		assert.Nil(t, parseErr, "Embedded manifests should parse")
+	}
+}
+
+func TestManifestFits(t *testing.T) {
+	defaults, _ := ParseManifest([]byte(`{
+		"pacman": [{"x": 0, "y": 0, "w": 61, "h": 64}],
+		"chomp": [{"x": 0, "y": 65, "w": 64, "h": 64}, {"x": 64, "y": 65, "w": 64, "h": 64}]
+	}`))
+	themed, _ := ParseManifest([]byte(`{
+		"pacman": [{"x": 128, "y": 0, "w": 61, "h": 64}],
+		"chomp": [{"x": 0, "y": 0, "w": 64, "h": 64}, {"x": 0, "y": 64, "w": 64, "h": 64}, {"x": 0, "y": 128, "w": 64, "h": 64}],
+		"extra": [{"x": 200, "y": 0, "w": 8, "h": 8}]
+	}`))
+	assert.Nil(t, themed.Fits(defaults), "Should fit, with more frames & names")
+
+	single, _ := ParseManifest([]byte(`{
+		"pacman": [{"x": 0, "y": 0, "w": 61, "h": 64}],
+		"chomp": [{"x": 0, "y": 65, "w": 64, "h": 64}]
+	}`))
+	assert.Nil(t, single.Fits(defaults), "Should fit, with fewer frames")
+
+	missing, _ := ParseManifest([]byte(`{"pacman": [{"x": 0, "y": 0, "w": 61, "h": 64}]}`))
+	assert.EqualError(t, missing.Fits(defaults), `atlas: "chomp" is missing`)
+
+	resized, _ := ParseManifest([]byte(`{
+		"pacman": [{"x": 0, "y": 0, "w": 64, "h": 64}],
+		"chomp": [{"x": 0, "y": 65, "w": 64, "h": 64}]
+	}`))
+	assert.EqualError(t, resized.Fits(defaults), `atlas: frame 0 of "pacman" is 64x64, want 61x64`)
+}
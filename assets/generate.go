@@ -4,6 +4,11 @@
 //go:generate file2byteslice -package=images -input=./images/powers.png -output=./images/powers.go -var=PowersPng
 //go:generate file2byteslice -package=images -input=./images/walls.png -output=./images/walls.go -var=WallsPng
 
+// Atlas manifests
+//go:generate file2byteslice -package=images -input=./images/characters.json -output=./images/characters_atlas.go -var=CharactersJSON
+//go:generate file2byteslice -package=images -input=./images/powers.json -output=./images/powers_atlas.go -var=PowersJSON
+//go:generate file2byteslice -package=images -input=./images/walls.json -output=./images/walls_atlas.go -var=WallsJSON
+
 // Fonts
 //go:generate file2byteslice -package=fonts -input=./fonts/arcade-n.ttf -output=./fonts/arcade-n.go -var=ArcadeTTF
 
@@ -3,4 +3,4 @@
 
 package images
 
-var CharactersPng = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01+\x00\x00\x00A\b\x03\x00\x00\x00\xbebr\x0e\x00\x00\x01\x83iCCPsRGB IEC61966-2.1\x00\x00(\x91u\x91\xdf+\x83Q\x18\xc7?\xdbh~LS(\x17\xd4Ҹ21\xb5\xb8Q&\x8dZZ3\xe5\xd7\xcd\xf6\xda\x0f\xb5\x1foﻥ\xe5V\xb9U\x94\xb8\xf1낿\x80[\xe5Z)\"%\x17\xae\\\x137\xac\xd7\xf3\x9a\x9ad\xcf\xe99\xcf\xe7|\xcfy\x9e\xcey\x0eX#i%\xa3\xd7\xf4C&\x9b\xd7\xc2\x01\xbfkvn\xdee\u007f\u008e\x936\xea\xe9\x8c*\xba:\x1a\n\x05\xa9j\xef\xb7X\xccx\xed1kU?\xf7\xaf5.\xc5u\x05,u\xc2#\x8a\xaa\xe5\x85'\x84\x83+y\xd5\xe4-\xe1V%\x15]\x12>\x11\xee\xd5\xe4\x82\xc27\xa6\x1e+\xf3\xb3\xc9\xc92\u007f\x9a\xacE\xc2c`m\x16v%\u007fq\xec\x17+)-#,/ǝI\x17\x94\x9f\xfb\x98/qĳ3\xd3\x12\xbb\xc4;\xd0\t\x13\xc0\x8f\x8bI\xc6\x19\xc3\xc7\x00\xc32\xfb\xf0\xe0\xa5OVT\xc9\xef\xffΟ\"'\xb9\x8a\xcc*E4\x96I\x92\"O\xaf\xa8\x05\xa9\x1e\x97\x98\x10=.#M\xd1\xec\xff\u07fe\xea\x89Ao\xb9\xba\xc3\x0f\xb5\x8f\x86\xf1\xda\r\xf6M(m\x18\xc6ǁa\x94\x0e\xc1\xf6\x00\xe7\xd9J~n\x1f\x86\xdeDߨh\xee=p\xae\xc1\xe9EE\x8bm\xc3\xd9:\xb4߫Q-\xfa-\xd9ĭ\x89\x04\xbc\x1cC\xd3\x1c\xb4\\A\xc3B\xb9g?\xfb\x1c\xddAdU\xbe\xea\x12vv\xa1G\xce;\x17\xbf\x00!3g\xc6\xc7j\xe5N\x00\x00\x03\x00PLTE\xfd\xed\xedn\x88\xc1\xa2\xb2\xd7\xfdܩ\xfe\xf6\xf6\xf9\xba\xb9\xfa\xac5\xf0K>\xfe\xf1\xdd\xfb\xbea\xf9\xc1\xbf\xf9\xb7\xb2\xf5\x8c\x84\xee7(\xfa\xb3G\xfd\xe3\xba\xfe\xe9\xc9\x00\x00\x00\xf2_Sl\xce\xd6\xfe\xee\xd5\xea\xee\xf6\xef<.u\x8e\xc4U\xc6Э\xe4\xe8\xf2aU\xfcӕ\xf3qf\xf7\x9d\x96\xf5\x8b\x83?a\xad\xfc\xdb\xdb\xf8\xad\xa7\x81\x97\xc9R\xc5\xcf\xfcҒx\xd2\xda\xf7\x9b\x93\xf9\xeb\x0eb\xcb\xd4\xee5&\xbb\xe8\xec\xfa\xca\xca\xff\xf9\xf2\xfa\xc6ŕ\xdc\xe2\x86\xd7\xdeg\x82\xbec\xcbԈ\xd7\xde\xd2\xf0\xf3v\xd1\xd9\xfe\xe8\xc7\xfe\xf3\xe2\xfb\xbaW\xf9\xa3\x1f\xfa\xad7\xfe\xe9\xca\xfcґ\xee3$\xf5\x8a\x81\xff\xf9\xf9\xae\xe4\xe9\xee2#\xfb\xbd\\\xf9\xa1\x1a[\xc8\xd2\xf3\xfb\xfc\xf2aV\xf2\xf4\xfa\xfa\xa9.\xaa\xb9ۣ\xb3\xd8\xd9\xe0\xefz\x92\xc6\xe8\xf7\xf9\xf5\x86}\xf9\xa2\x1dJj\xb2\xe5\xe9\xf4\xfa\xc5\xc2V\xc7Ѹ\xc5\xe1\xfe\xf5悙\xca\xfe\xeb\xcf\xcb\xd4\xe9\xf3pe\xff\xfb\xfb\xee\xf1\xf8v\xd2\xd9\xf7\x9c\x95\xd0\xef\xf2i\x84\xbf\xfc֚\xfb\xc1gAb\xadVt\xb7\xf4\xf6\xfa\xd1\xf0\xf2\xfb\xfc\xfd\x9a\xdd\xe3\xe5\xea\xf4\xde\xe4\xf1\xed\xf0\xf7\xf9\xfd\xfd\x98\xdd\xe3\xfa\xb8S\xdd\xe3\xf1\xf9\xa5$x\x90\xc5\xfe\xf2\xe0\xf9\xa5#\xfb\xce\xca\xf5\x83z\xfb\xd0\xcd\xf4\x80w\xfa\xc7\xc7\xfe\xf3\xf2\xf8\xb3\xb2\xfa\xc9\xc8S\xc6\xd0\xdc\xf3\xf5\x8e\xd9\xe0\xe7\xeb\xf5a}\xbb\xfa\xb3E\xfa\xab3\x91\xa4\xd0\xe4\xe9\xf3\xfb\xd1\xcf\xf2dX\xf9\xa4!\xb5\xc2\xdf\xf1SG\xf0E8Xu\xb8\xf6\x8e\x85\xde\xf4\xf6\xfa\xb1B\xfa\xb5K\xfc\xe1\xe1\xe6\xf7\xf8\xfc\xc8z\xf8\xb2\xb1\xa5\xe1\xe6\xfb\xd7\xd7}\x94\xc7\xf9\xba\xba\xaf\xbd\xdd\xfd\xee\uec7f\xde\xe1\xf5\xf7\xc6\xec\xefPo\xb4\xf9\xa6'\xfd\xfe\xfe\xf9\xa7(\xfb\xd3\xd2\xf9\xba\xb5\xff\xfc\xf9\xfc\xd9נ\xdf园\xd5\xf6\x92\x8a\xfc\xe0\xdf\xfd٣q\x8a\xc2\xfd\xe8\xe7\xce\xef\xf1\xd6\xdd\xed\xfcΈ\xfe\xf6\xe9\xfa\xb7P]z\xba\x9f\xb0\xd6\xf9\xbd\xbc\xfa\xaf;\xf5\x89\x80\xefC5\xfa\xfb\xfda\xca\xd4\xfcԗ\xfd\xe7\xc4\xd3\xdb\xec\x9d\xde\xe4\xfe\xf0\xf0\xfd\xea\xea\xc0\xea\xee\xfd\xe7\xe5\xe1\xe7\xf2\xf8\xb6\xb5\xf3tj\xfb\xcd\xcd\xf9\xb2\xac\xab\xe3\xe8\xfb\xc7w\xc5\xcf\xe6De\xaf\xa5\xb5؍\xa1\xce\xc7\xd1\xe7\xfd\xe8\xe8\xb2\xc0\xdeFg\xb0e\x81\xbd\xf8\xb1\xb0\xfd\xe7\xe7r\xd0\xd8\xc3\xeb\xee\xbe\xca\xe3Ac\xaeQp\xb5\xfb\xbbZ\xda\xe1\xef_|\xbb\xff\xf8\xef\xfb\xd4\xd0\xff\xff\xff|\x93\xc7\xf5\x88\u007f\xfc\xd7\xd4Q\xc5\xcf\xc1\xcc\xe5\xf6\xf8\xfb\xa8\xb8ړ\xdb\xe1\xe4\xf6\xf7w\x8fŽ\xc8\xe3\x90\xda\xe1\xfb\xc8yRp\xb5\x8b\xa0\xcd\xfc\xc9|\x8f\xa3\xcf\xd0\xd9\xeb\xf9\xa8+\xfe\xf2\xf1d\u007f\xbd\xf8\xa7\xa0s\x8c\xc3\xf7\xa4\x9e\xfb\xd4\xd4\xef\xf2\xf8\xfb\xd6Շ\x9c\xcc\xd7\xde\xee\xf8\xb4\xb3\xfb\xfe\xfe\xf8\xf9\xfc\x93\xa6\xd1Yw\xb8\xd8\xdf\xee&E\xc9\xe6|w\x1c\x00\x00\x01\x00tRNS\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x00S\xf7\a%\x00\x00\x00\tpHYs\x00\x00\x1d\x87\x00\x00\x1d\x87\x01\x8f\xe5\xf1e\x00\x00\v\xd2IDATx\x9c\xed\xdc\x0f\xb0TU\x1d\a\xf0\x87>\x840'\a|\x06\x1a\xee[\xaf\x83\nKeS\x98\to\xb6\x8d)\x9b\x02s \xf5F\x89Dk\xf8b\xb40\x84\xf8#\x85\x91\x8cR\xcebP\x86G;\xda\b\xa5\b\xcf\xc1\x90Ph4\xfc\xd7\xd8U\x98\xc9\xd13vep\xfa\xe3@\xf4\x87?\x13 \xdb\xf9\u007fϿ{\xf7\xdc\xddէ3\xde'\xcf\xc7w\xef\xdd{\xee\xe7\xfeι\xe7.\xbb\xaf\xa3\xfe\xde\xe2\xbbt\xa4>r\xae\xb6\xe4}ފ\xba\xe4۴\xac.9w\x1b\xa9K\xbeMcuq\xae\x91bu\xaek\xf1\xdem\xc5^|7-;\x16\xdfm#{\xf1\xdd4v,\xd6J.+'\x94\xbf\x96C\xcaW\xcb%\xe5\xab\xe5\x90\xf2\xd5rI9\xb4l\xabt)/\xad\x14)\x1f\xad4)\x1f\xac\x14)\x1f\xad4)\v˲ʦj\xa4\x95!\xd5P+\x83\xaa\x91V\x86TC\xad\f*C˰j(\x95\x8dՀ*\v+[*\x1b\xab\x01U\x16V\xb6\x94\x8e\xa5[\xf9Pe`5\xa4J\xc7jH\x95\x81Ր*\x1d\xab!\x95\x8a\xa5Z\xf9I\xa5cyP\xa5ayP\xa5iyH\xa5byP)Z\x8a\x957U\x8a\x96\x17\x95\x1bˏ\xaa\x15+'\x96\x1fU\xabV\x0e,O*\x17\x96'\x95\x13˓ʅ\xe5I%\xb1\x12\xab\\T6\x967\x95\x03\xcb\xdb\xca\xc6\xf2\xa6r`y[ņUN*\xd3*\a\x95e\xe5Oea堲\xac\xfc\xa98\x96\xb0\xcaKeb\xe5\xb12\xb0\xf2P\xb5be`\xe5\xa1Ҭ\xf2S\xe9X\xb9\xa8Z\xb1ұrQ\xb5b\x15\xb7h\xa5b\xe5\xb3Ұ\xf2Q\xe9X\xf9\xac4\xac|T\x8a\xd5\xdb[V:V\vV9\xa94\xac\x9cV\xb1\xb0j\x95J\xb1\x9a\xbcdǉ\x8fn\xbe\xfa\xfb*\f\xcd\xce\xd42'զ+\xbf:\xf6䳆\x9e\xa2\xcal\xfa\xd0H3sYՆ\xdc\xfc\x93Ǻ\xc7\f\xd4dh\xf6\xbd\x81N+E\xe1\xb7\x1f\xfb\xc3\xd7\x1e\xbffڂX\xcbF\xe0\xec\xe3\v\xdaa\xa5I)T'}\x02\x81\x10\x00\x14\x9e\xf9I\x99]t*B\b\x00\x10^\x9ci5~B\x0f^\x0f\"\xd4}\xcb\xf92;\x8be\xf0\xde[\x1cV\x89A_\a\x02xE\x14\x1e\x8a\x8a\xe2kU\aIps\x0e\xc8\xcce\x15\xdcW\x05t\xdb;\x87\xa9\x19$-A\xb7\rS\xb1:\x9a\xa1\xaa\x1b\x8b$\xb8\xba\x84X\x03\x11\xb8\xfca\x9e\xbd\xafD\x03\xc2\xf5\xa9\x93,\xac\xa4\x80\x86 F\x85[y\xab\xccH\x93I\xa3Qτ\f\xab'zB\xb2\x8b\x10\xff\xe9\xa0e\x84YƄ\x88\xef\x17=&\xb2\xa2Ē\x02\x17|\x03\xb1\xfdB\xd0{M\x90d\x10̺\x16\x87\xbd\xdf\nZ\xb32\xa9\xa4\xd5l\xbaS\xfcEZ\xfd\xede4{\x88\xb6$\xc4Ն[>xr\x9a\xd5\xf89\x92\n/sY8\a\xf2\xe3 \xe9\xdcT\xab\xb1\x88R\xe1\xef\xf8k+\xab\xa0\xb1\xf8GR\xdft[\x9e)\x9dP\x00,Xȟ\x1e\xd7=\x82\x8bXU-\xc4{[|S\xa1\xb0\x97p/j\xc5ʒ\x92T\xcb\x06#~p\xa4\xd5h3\r\a\xd3\x03%\xe7\x17k\x85\xe7\xa4YݐP\xe1\r\xf6/\xa7\x19\xfb\v\xf7Z\xbf\xc9\xc0\x12T\xff\xeb\x04\xe4\xf9\x01\xdb+\xb8\x83\x86\xf3\x10\xcbH\x89\xa3\xf0\x0es\xc0\x12\x00\xbfC\xea\xe9\x98\xf8\x19\x9a\x91\"[W\xc0ˏ\xf0\x0f\x13\x87K\xac\x8e\x96\xef\x03\x13\xab\x8b\x01\xdb+o5\\\x82\xb3\aiKx\x9b\x01\xeaYa`\xf1\xc3_>\x8f\xad\xb7\xfd\xb3\xb4\xed藤\a\xbe\f\x84\x1d\xed\xc1'\xa7Xus$VZ(\\\x85k\xa8;dH\x80\x95\x1b\xceX\x1f\x14X\xa2\a\xf6\x02\xfe\xf4d?\x00\x8e\xc6\xd9y%\xbcM\x17\xa1*\f \xf56\xbaY+\x17\x95\xb4\x1aG\xcbGZ\xd1\xc2\x1a\xc7Z\x82K\xfc\x91.\xdcr`\x14\x968\xfcIt\xc3\xed\x1b\n\x85\x8d\x906\xff\x14RV\xc2\n]{\x14\u007f\xeb9\xdfm\xd5C\xfb\x1a/#\xfc\x03)\xa2Nqz\xd0\xd1.\xc2f\x14\x96,+\xd2\xf3\xf8\xd9 \xd5_\r\x82\xe0>l\x06\xf6Q\xab5x\xd8\a\xbb\x9b\xb3rJI\xab\u007f\x86tx {ݷwc\x17D\x8fV*w\xc9^4\xe5\xf9\u0086\xed\x80d.\xab\"5\xfe\x13i\xe0k\x14\xfc\f\x9c\x1d\xe1V\a\a\x14f\x9c\x80{\xf0P\xa7\xd5@L2\xf3\xf3o<'\xb1^\x88\xa2\xdf\xcbB^\xfdt\xe1_KiF\xcbʰz\\\x0e\x91\x90^\xaa\xe1\x8bq\xbc\x93\xfc\x95\xd5\xd5U\xf4\x91aq\x10p\xabV\xa5\xa4\xd5l\xd6\xf3\xf0\xd7_g\xe0s\xb2\x18\xbeLF{\xd6\x10\xf8\x12\x8e\n\x1b\x1e\t\xb7\xba\xad^!\xeb\xbdD\x1b\xf8A\x8a;\tg\xb8\xed\x94\xf0z\x12\xef\x02\xe0\x06\xa7Ս!\x9a\xf9&^\xe1\x191@u\xe2L\fT[Ȧ7UѼH\x1b܅՝r\x88\xe4C\xd6߂\xf8u\xfc\xff0d\xe3\x15\xcd\x17a+\xb2\xe4\xb0J\xa5\x12V;\xb8\x15\xaa\xae!;:\f\xd1ݕ\xbf\x8b\xbaz\x96:\\\x11\x86\xd79\xad\xb6\x92\xf5\x9e\xa2\xebl\xa0\r\xfco\xb9\xbc\xfe\b\xed \xfc\x1c\xaf\x03h\xa4\xcb\xea\xc9{@x\x02Ya\xc6W\xc4X^\x8b\x0e\b\xab\xc3t\xdb\a\x00`+\x17u\xab\xa0Jۻ\xfb؛뎳s:\"\x0eJt\x9e\xd1\xf5\xd1B\xe1\a\x90\x9e\xac\x95y\xadҥ\x92\xba\x02\xbc\xae\xb6\xd3\x06~\x1d\x96*\x95?\x8b\xba\xfa\x0f\xcdV\x87%w]\xfd\x854j\x96\xa8+\xbc\xc1\xab\xe5\xf2\xa1^VWl\xec\xf8\a\x04\x93\xf4\xc1]\xcc\x18\xaa\x88=\xf9S܊\xd4\x15\xbb\xf0\xa2\xf0*\xfaȔ\xb0\x93wA\xa3\xae^\xa7;8\x1b\xaf\xf2\xf47ia\xed!\x19\xde-\x84p\xe9A\xc8\x06\xcc=\xf9\xac\xb2\xa4\xa4\xd5\xddl0\x15Ǽ\x06\x9eZ\xa9|N\xd4\xd5\x1b\xecp¬\xf1\n\xd2\xf1j\x17\xbd$\xd1\xf1\x8au\xc1\xa5|\xec\x00C\x1dVE26\r\xa0k\xbc\xc6\a\xf8?FѪ\x90\xb7\xe5l\xfaȾ\xf0\xd7\xee\xf1j'\xb9\x90\xb0\xe7\xdfKO\xea\x8b,#\x83\x17\x91\xa2VøU\xece\x95M%\xaf\x83S\xd9\xe0\x0e\x8e>Ov\xfe\x058\x1dg\xeb\xf9Xp\x05\x89.\xed\xacnN\xbb\x0e\x92\xcb\xf3v<\xee\x1c\xa3'\x13\x9c^.O\xe8eW\x05@\x8bc5\xcdL+r\xf8\x9d\xe0\x18=\xda/1+t/\xb96\xf2\x89\xfcs\xb4$;I\xa6\xce\x19\xe4u\x90\xecl\x97\x98\x1f\xe0&\fg\x99\x98\xa6Ц\x04\xdc*\xf0\xb0j \x95X\x9dæ\n\x10]Bv>\v>\x84\xb3\xe9\xcc\n\x80\x8dxD\xd9\x02\xc1\x8f\xddV\xcbK\xec>c\xd6A6\xd2\x0e\xc1٠\x12`\xad&\x84_\x86G\xfaʖ\x15\xa1*\xde_=x)\xde߳=l\x90\n\x87\x909W\xc8:!\xfc\x15>C\xc7a\xd8\xe7\x1c\xaf\xe2\vȐHOc\xe1z\xb2\xe3_\xf0L\xceNq\xcbw\n\xaa\xc6V\r\xa5\x12\xab\xc9\xe3HՒ\xce>e\xddO\x8fõt.?\x95\xdd@@ص\x0f\x1f\xfbڊ۪<\x81]\xb5\xf9\x94\xaa4\x88d\xef\xef\xe5\xad\xeeݲ\x14\xf6\xee\x1fdYQ\xaah\xfe\xeep\xf1\xe1\x01\xabK\xacסn:\x97\xefaS\x860\\\xfc\xc0<H3\xd7u0^\x897\x99I\xa4\v?\xc7\xfb\x99x\x1e͒\xd9)\xa2s\xf9\xb7ªra\x88D_\x87`\xea]4\xfb\xb4\x98\xe7\x85\xe1\x110\xf5\xba4+6k\x107:\xfcF\xf9\x10\x9d5\x00؋\xbf\xc0\xabeˊ\v\xdcX\r!\x148\x9d\xf3i>\xa6\xca\x06\xf7\x90\f;\xfby\x0f\xb4\xfa`\x1c߆a\xc8\x14g\x1d\xb9\xfc}X\x1d\xf1\xf9\xe0\x81G{o+\x0f,y\xfc\x95\x1d\x9d\xb4\xb0p\x1d\x81\xc1KD\x06\xf9\x19\x02h\xf0\x8aJ\xaa\xd5\xf2\xefBY\xf8#ǳl\xd0\x1c\xd2Gȳ\x018\xaalY\x15yaE7W\xd9}3\xfe\xda\xda\xc7\x01\xef\t\xab\xac\xce\x00˜\xf3v\xdc\xe3\xf0\x8d2\x9c\xf5̮\xd2\x11\x80'\aI&\xce0\xc9\xfc\xad\x1ak%\x00\x95\x8bN\v\xd9]\xce\xda\xef\xc8\xec\xe1\xd3؈\x05\xa6/\xab\xa4[\x95ˣ\xf8m\xec\xb6\x1f*Y/k\xf3\xb63ʶU$\x06\xa1\xa8o+\xa07:a\xf7|\xa9\xb2j\u007f\x95\xddmuGO&\xafj\x99Vq0\x82L\x11\xf0\xe6_\x9c\xa6d\x90\xdd\xfb\xdc>M\xa1\xf2\xb2\xf2\x9c3\xb0e\xf6\x83\x1f\xb8\xfc\xc4\x1d+\xd4h\xf2\x858[\xabg\x0e\xab\xf2\xa0\xb9\xff~\xe5\xb2QW\x9e\xaegE\x92i\xaf\x95\xaau\xc5++\xba\xecж\x9f\xdd\xffD\x9f\xe2\x87g^\a^\x90\x99Xײ\x8a\xe3a{F/\xfc\xcdʏ\fO\xcbrZy\xcdE\xf3-\x0e+\xefE\xd6UQ\xf9\x93,\xee\xc4\xdd\a\x1b/An+\x8f{\x9c\xb7ݪhB\xa4`\x15\xc5w\xc7\xd8\xee\r\x95\xcb*]\xab\xff\xea\x8av\xac\x1ag0\xa0\x8a\x91\x92K\xbd|VA\xd3ViZ\xfdWW\xf4\xf0\x8b5\xdd\xc6\xc2J\x02s.\x9a\xca\xe2\\\xda\xf9\xbah?ԕԢb\xf4\xbf(\xf1\xa9E\x89e1{\xbc\xf2\xa0\xcam\xd5һ\x89ފ\xba\xc2\xdfj5\x85\xa6\xa8\xc8)\x95'\x1eI\xb1\xf2\xa1jª\xa5\xb7\x13\xb5\xbf\xaej\xb2\xaej\xdc)bcXM<\x98\b\xa6֕\x17U\xd0Կ\x0f\xb6\xf2\x1e\x99\xb6֕\x14\xb1몖\x88%\x8f\xa4X\xf9Q5i\xd5\xe2\xbb\x19\xdaXW\n\x97ZW\xbab\x83\xba\xf2\xa4j\xdaJ\xe5\xea?+e\xb0\xe2E\x94\x8cW\x89X\x83\xba\xf2\xa5\n\x9a~\uf1e2\xf5N\xa9+9H)\xa5ָ\xae\xbc\xa9\xde\xe5VŤ\x80d\x11\xd9b\x99u\xe5O\x154\xff\xfe\xab\xa4\x13\xf6c]i\x15\xa4\f\\z\x8de\xd4U\x0e\xaa\xa0\x1d\xef\xeb\xebǺ\xaa)\x85\x95\f\\\x91v!̪\xab<T\xadX\xbd\x03\xc6v\xf3B\xa8\xf69u\fK\xab\xab\\Tª\xa59V?\xd6U2\xdd\xd4_\x9e)&S\x87\xa47Zu\x95\x8fJZ\xb5\xf2&\xac\xfe\xad\xab\xa2UZIq%\xa5&\x06.\xd5*'ջܪ\xa8\xbe\xca`\x96\x95\xa1H\xb4\xb4\xd7\x19\xf2R%V-\xbc\xdeЏue\x17U\xf2\xf2\x8bz\xb3(o\x81\x12\xab\xdcT\xaaU\x1e\xac\xba\xcb\xcaR\xb3\x19ՕU+[\xcdJ\xb4\xb5E]\x11\aE\x8d\xfdQ^\xae\xe1/3$\xabH+z\xf4\xf8'\xc3\xc3J\xeaI\xa2}ֲ9\xaa\xbar\xf4V\xe9\xd8\xc5䲲K\xccJ\xcc\xd5%\b\xfdQ\xad/=\x10/\xc4p:a%\x1c\f\x1a+\xa9+I3\x9fKu\u007fnI\xaf1WP\xb1WW\x8f\xdd\xeeeVP7\xac\xe4\x85M\xa9+3\xd0WQ\xad\xea*D\xe0L\xb4\xa0\x1d\x9fwV\x8e]\xb5I\vꦕ\xc8-\x99\xb4DZ\t\x19!A\xbb\x9b\x1e\xa8\xab\xe8u%\xf2ؐQ\x12=h\xc7\xe7\xe8U\as@r\x05u\xdd*\xc9M\x98\xbaEeXI\a9\x1eEjR\xd4V\xa9GZ]%yl\xc8\xd8V,i\xc7\xefg\xd0\x1c\xea\x96L\xdd\nꪕ\x9a\x1b0\xc6\x00%\x13y\xe8J\xce_OV\x13\xa5\x8f\xcau\xd8\x0f\xd8J\xcdc\x1dFb\x19I;~\xef\x87\xf1!7K\xa6n\x05\xf5\xc4J\xcf\r\x18\xa3\x93\x8a\x84\xfd`|\xc8-J^N\x97\x89f\xa7lb\xfe\x96\x8aX\x87\xe1XfҎ\xdf'c~\xd0Ԓ15\x93m\xac\x0f\xe5Z\x9f\xa64\xa9\x92U\xcc\x0f\x9aF\x91\x9d\x98O/\xac\xcc\\\xaf3\x1aXI\x9cbU\xb7\xb8R\xd7s,\xf6'\x9a\xfd\u007fU\x91\xad\xd7x\x15\xbe\xd82\xfe\xbf\xaa(n\x18\xd43\xac\xde[\xcc\xe5\xff\xf0(\xea\u007fj͠J\x00\x00\x00\x00IEND\xaeB`\x82")
+var CharactersPng = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x02\xc0\x00\x00\x01A\b\x06\x00\x00\x00y+\x19\x0f\x00\x00^PIDATx\x9c\xec\xdd[l\x1cW\x19\a\xf0\xff\x99\x99\x1dw\xd7\xeb\x8d\xe3\xc6isk\xea\xa4!\xa6MRۅ\xd0&U\xd5\x16\xa2J\xa0\x96\xa4B\x14qk\xa9(}\xa0\x84\xb4\xc2Q\x88hy\x85\x04\x10\xad\x04B<\xb4\x14\x81\xe8\x1bE\xa0\x8aK\xe1\x85\xdbK\xda$Ph\x93\xd8q\x88\xda\x10\xe1ڹշ\xf5\xcc\x0e:c\x7f\xbb3\xde\xf5fv=3{f\xf7\xfbN\xab\x99={\xf6\xf3\x99=+\xf9\xb7_f<\x1a8888888888Z(\fډ2fFs\x0e\xed\xd7\x12mݗ\x05\xed'1f^\\]\xf3q\xb7=|.\x91\xc7<>\xb0\xb1\xe6c\xedz}8\xb1\xeb;\xfd\xab\x97k>\xdek>\xbe;\x91ǻ\xe7\xafGk>\xd6_\xee\xecO\xec\xdar\xe3ƍ\x1b\xb7\xe6oB5\xf4&\x1d\xc4\xf5\xa07\xa9\x18\xae\a\xbdI\x06q=\xe8M*\x86\xebA/\x83\x98A\xcc f\x103\x88\x19\xc4I\x01\xb1P\x1d\xbdI\x81p\x98\xf0U\x1d\xc2a\xc3Wu\b\x87\t_\xd5!\x1c6|\x19\xc2\fa\x860C\x98!\xcc\x10V\x11\xc2\"I\xf0U\x11\xc2Q\xc2W5\bG\r_\xd5\x10\x1c%|U\x83p\xd4\xf0e\x043\x82\x19\xc1\x8c`F0#X%\x04\x8b\xa4\xe2\xb7\xd1\x10\x8e\x13\xbe*@8N\xfc6\x1a\xc2q\xc2W\x05\bǉ_\x860C\x98!\xcc\x10f\b3\x84U\x80\xb0H2|\x1b\x85\xe0F\xe27n\x047\n\xbe\x8dBp#\xf1\x1b7\x82\x1b\x05_F0#\x98\x11\xcc\bf\x043\x82\x1b\x8d`\xad\x19\xf0\x1b\xe7\x9cT\xc0o\\\xf3P\x01\xbfq\xceC\x05\xfc\xc65\x0f\x15\xf0\xab\xd2<88888Z+\x84\x8a\xc8T\xb5\x12\xac\n~\xe3\xa8\x04\xab\x82\xdf8\xaa\xc1\xaa\xc07\xaeJ\xb0\xaa\xe8\xe4j0W\x83\xb9\x1a\xcc\xd5`\xae\x06s58\xaej\xb0\xd6L\xf8\xa5yF1W\x15\xf1\x1bռT\xc5o\xabE\x140\xe7\x8a+W\\\xb9\xe2\xca\x15W\xae\xb8rŵ\x11\x15\xd7\xc4\x028i-L\x04\xab\x8a\xdf(\xe6\xa7:~Þ\x9f\xaa\xd5\xdf(\xe6\xa7:~\x19\xe7\x8cs\xc69\xe3\x9cq\xce8\x8f\v\xe7ZܘL\x1a\x82U\xc7o\xd2\xe6\xa9\x12\x82U\xc7o\xd2\xe6\xc9\bf\x043\x82\x19\xc1\x8c`FpR\x10\xacŁȤ\xb6VBe\x14\xd5U\x95\xe7\xdaJ\xa8L\x1a,\x19\xc1\x8c`F0#\x98\x11\xcc\b\x8e\x1a\xc1Z\xb3㷕\x00\xbf\x14\xb0'\t\xbf\xad\x18K\x01;\x83\x92AɠdP2(\x19\x94Q\x83\xb2i\x00\xdcLp\xac\xe7XZ\xad\xfa\x9bĨ\x17\xed\xadV\xfdMb0\xda\x19\xed\x8cvF;\xa3\x9d\xd1\x1e%ڵ\x00cZ\x16\xc1\xadR\x05Nr\xf57\xc9s\x8f\x03\xee\fI\x86$C\x92!ɐdHF\tɦ\x02p\xb3aQ\xfem\xe0Z\xfe>pҫ\xbfI\x9f\xbfj\x88\xe4\xf9\x877\x7f\xc6;\xe3\x9d\xf1\xcexg\xbc3\xdeU\xc0\xbbA;͌_\xda\x0f=\x84\x0e\xb1\xbc\x17b\xc5\x00\xb4\xee\x01\xa0}\r\x9c\xb1\x7f\xc2\x19;\x06\xe7\x7fG\xe0L\xfe\x97F֟\xef\xdd\xe3p\xc6\xffQ_\xbe\x90+\xa8by\x17\x8c\xad}0\xb6\xf4A\xbf\xb9\x0fΥ1Xo\x1c\x87\xfd\xc61Xo\xfd\v\x98\x9d\xa5\xa1\x81\xc2ͷ\xad\x7f.\xdf\xfbo\xad;\x9f\xac\x02\x87~\x83\f! \x96\xe5\xa0uvBtvA\xa4\xd3p.]B\xe1\xc2\x058\x17/\xc0\x99\x9a\xa2\x91\x81Ct.+\xcf7>\xe6n\xeb\xc9\x17& ;S)l\xee\xc8`c6\x83M\xd9\f.\xceZ8ye\x02C\x13\x93\x18~o\n\x05'p*_\xbeM2g\xbb'\xdf{\x93\x18\x9e\xa8=\x1f\a\a\a\a\aG\x98!\x9a\x15\xc0\xf5\xc27h\xf5T\xb4\xaf\x81q\xe7s\x10\xd7\xdd\xee\xfe2\xd7l\x1b\x05]\x97OA\x13\x02\xb0,\xd8o\xfd\x18\xf6\xb1C@\xe1\xea\x90\x13\xd9us\xf9Vn\xa7.\x1f\x12d~\xfb\xcd\x1f\xc2>\xfam\xea\n\xe5\x0eq\x81\x00,\x04\xda\x1e\xfa\x1c2_=\x00\x98&\xf5\x02v\x1e\xd0\xe7\x1e\xdbCC\x988\xf0\x04\xec\xb3#\x80mӈ\xca!\xf3}\xeaad\xf6\uebdc\xcf\xce\xc3\x1e>\x8d\x89\x83\xfb`\x8f\fѳK\xbeC\\\xd0\xea\xa9\xc8fa\xf4\xf5C\xeb\xear\xd7\xc0]Oo\xb3,X\xa7N\xc2>u\n\xb0\xa6\x00#]u+:\xae\x85q\xdbmn>JAA\x9f\x1d\xeb\xc4\t\xd8\xc3CU\xf3\xb8?\xa7\x86;\xc4\x05\x01\xb0L\xf4\xc0\xea\x95\xf8̺\xeb\xa1i\xc2M\xb4\xf0x\xcfNN\xe3\xf0\x89\x11\xbc35C]\x81\xf2\x19\xb0\x8a\xebI\x9f\x13\xd9F&\xa6\xf0\xbd\x93g\x02\xe5\xe3;\xc4\xf1\x1d\xe2\xf8\x0eq|\x878\xbeC\x1c\xdf!.\x8a;ĉf\xc3\xefR+\xbeA\x00\xac\xddx?\x8c\xdb\x0f\x03f\au\xf9\x82\xd0$\xb7b\xec8\xac\xbf<\x01\xe7\xf2\b=]\x16ں\xfb`\xec|֗o!\xbc\b\xc3b\xf4\b\xac?\x7f\x19\xce\xc4;\xf4T\xdd\b\x0e\x82_Y\xa5\xcd<s\b\xe6]wSW\x19~\x8b\xfbv\x1eS\xcf}\a\xd3?{\x9eF-\x9eo\xe7\x0e?\x8eh\xeb\x8d|\x1e\x93\xcf~\v3/\xfd\x94z\"\a\xb0\xbev\x1d\x8c-[\x00M\x03\fÿ\x16\x96\xe5\xf6\xd1\xe3\xc2\xf88\xac\u05ce\x94W\x83=X\xd5V\xadF\xaa\xbf\xbf\x98\x8bZ1\xa7\xa7\x15FGa\x1d;Z5\x1f\xed_\r\xc1A\xf0\xdbe\xa6\xb0w\xd3\rض\xac\xc2\xe7س.\x05-\x05\xcbq\xf0\xe2\x99s\xf8\xed\xf9w\xe1\x04\xcd7\xff\xda\xff\x9c\xbb\x82eY\x13]Y\xad\x983/\f\xbcp\xe6\x1c~_%\x1f\x03\x98\x01\xcc\x00f\x003\x80\x19\xc0Q\x01\xb8\xa9.\x82[*~\x83\x84\xb8\xee\x0e\x18w\xfdh\x0e\xabv\xbe\xf8\x8b\u07bb\xd5<\x15P\xb1\xa2\x0fƇ\x7f\x0e\xe8m\xd4\xe5\v\xb1r;\x8c{\x9e/\xe1דC\"IV\x92\tK\xf2\x7f\xa7\xfb\x030v\xbd\xe4\x9e.A9\"\v!\x90=\xfc\x83`\xf8\x95M7\x91~\xf2 \xcc\a>A#\xcb\xc2ͷs\x87\xef5e\xb9ik\x9a\xc8\f>S5_\x98M\xeb\xee\x8610P\x19\xbf\x9e\xa0\xf5\xd5r9\xa4\xb6\x7f\xa8\x04S\x0fP)_\x11\xbf\xf3\xebH_d\x8a\x9f\x11\xcf\xf1\xba\xe3\xab\xe4s\xff\xf3\xee/!\xe4g\xe9\xeb\xbd=s\xf8\xb5\xf3es\x91P\x95s\x95\xf8\x95]\xa6\xa6\xe1\xb1\xf5+q\xe7\x8a\xe5\x94\xc2\x17\x92\xf2n\xbel\xe9s~\xfe\x82\x85/~\xf3\x8f\xf8\xc2ӯ\xe2\xc1'_\xc1\xf7\x7f\xf1o\xb7z.s\xcb|\x8foX\xbbh>\xbe\b\x8e/\x82\xe3\x8b\xe0\xf8\"8\xbe\b\x8e/\x82\x8b\xfd\"\xb8f\xbfȭ\xee\xea\xaf\xde\x06\xe3\x8eC\x15\xe1GC*AIt\xac\x87\u07b7\x9f\xba}a\xec\xf8n\x19<\xe4\xae<\x9dB\"E≪\x8f\x841\x91\xb9\x01z\xdf \xa5\x88,\xdav\x7f\x12F\xff\a\xe9a\xf9q/ܟ\xdff\x9e:\b\xed\xdanzE1\xda\xf6<\x04cۭ\x15_S\xec\xd3M\xdfk\xe4{\x93\xd9\xff\x94[9\xa6\xaez\xaa\xd9W\xad\xfe\xea\xba{ڃ|\x8f\xa9\xcaKP\xa4\xfd\xb20\f8\xb9\x1c\xf4\xf7m.\xc1\xd4\x03Tc\xeb\xd6\x12\xa4\xe7׳\x98\x8f\xfa\xb5\x94o+\xda\xdb\x17\xcd\x17f\xfb\xe8\xf5+\xdc\xf3}\xab\xbd\xf7Zavn\xcet\n\x8fn\xe2\xb1\rk\x91\xd1u\xef0\xb7}lU76\xa6\x8dR\xd5\xd8qp\xe8\x85\xd7p\xfa\xed\xcb4\x04/\xff\xe94^\xf9\xfb\xdb\xc5u\x95\xef\x83\xccg,\xf8\x82QO5\x9b\x01\xcc\x00f\x003\x80\x19\xc0\f\xe0\xba\x00\x9c\xc4\xd3\x1f\u0082o\xd0з\xed\x83\xd3\xd1SĀ\x0fp\x1e\xc4Tjz\xef\xa3\x10]\xb7\xd0C\xb7\xe9[\xf7B\xe46\x94\xc1\xc3[u$|\x11\xa0\bO\xfa\xe6\xc7!:{\xe9%\xf5\x81\xbeJH\xc0\xa6\xf7\x1d\xa0\x87e\x95\xc1\xb3\xe7\xaf\xe0ʴ(!\x96\x8eA7!:rH\x0f>]~\xea\xc3\xe0`\xe5\xf7\xa8\xc2{I\xf9\xe4x\xe7\x9and\xbe\xe6\xcf\x17v\x18\x9b{\xdd\v\xd3\xe8K\vm\xbdA\xe7xSE\x97\x80l\xdct\x13D:\xe3\xab\xdc\x1a7\xdf\x02qMڷ\x9e\xb4\x96T\xdd\xf7\xae\xb3\xf7\xe7\xf9\xf2yrz\xf3\a9\x9dc\xb1\x90\xa7*|v\xfd*w\x1d\xab\xbd\xf7\v\xd7G\x8e\xcf\n\x1b\x8fܸ\x9a\x9eq\xdbr3\x85G\xd7\xe4Jk\xab\x9b\x18\xbb8\x8d\xd7\xdf\x1c\xa5!\xc5\xf8\xdd\xdf\xce\xfa\xbe\xf0dt\r_ڰ\x96\x9e\xe6\n0W\x80\xb9\x02\xcc\x15`\xae\x00s\x05\x98+\xc0\xd5*\xc0q\u0097B\xeb\xd9\xe3\x83L\x19\x14\xaa5݄\xd6\xf3 =r\x9b\xb6\xe9\xd3\x15\xe1!+o\xde\x7f.\x9f\xb5\n.(\b]\xb2\xbf\x90JA\xeb\xd9M\xa9j\x8e\xabULS\xf7\xec\x82\xc8Ο\x96As\xd4M\x17\xbe\x8f|\xe3U|\xfe\xe0\x1fp\xffW~\x8d\x9f\xfcf\xb8\x88#ﱘ\xf7~\x04H\x95\xb0k\u07bd\v0<\xa8[\xac\xe9&\xc6/Mc&o\xbby\xa9jj\xde{\x1f\xa0\xeb\xd5_\xbbx\xfc\x9f\xbds\x01\xae\xe2:\xef\xf8\x7f\xef\xdd{\xa5{\xf5@B\b\xeb\x85x\x18[\x86\x84\xd8&v\f\x98q\xec:q'5\xe30n\xdaI\x13\xb7qZ\xd7\x13g\xd2&$͌\x93:Cp]\xa7\xd43Ԯ\x93L&\x03\xb1\xe3\x12\x922\xae\x13O=\x99\xc9\xc4\x11%L\r\x18\x02\b\x10 \t\x81\x84%$\x04z#]\xe9\xde}d\xceўݳ\x8f{%\xa4+$\xc1\xf7\xff\xd0\xdcsΞ\xfdv\xf7\xee\n\xfd\xf6\xdb\xef\x9c\x1d\xb7\x14\xae\xae\xe6\xc7`Cn\x80\xb9ν\x04\xc1\f\x88C\x8b\x16Ye+\xfd\xa1\xb2ҕ/\xec\x05iq\\\xec8/\xf7:\x80˖\xb9\xfcy\xa3\xc0\x13\x88\b\x8f\x171]WR\x14\x1cu\xf5\xc2p8\xea*\xb3\xfde\x90\xfb\xe0\x82b>\xd8M\xfc<\xb0\xa0\xd8I\U00071b85\x8b\x97\x87\x84W\x97.t\fB\x83\x13agv\x7f\xd1<Q$\x91H$\x12\xe9\xbaHM\xd3N\xe0+\x81/\xff\x97S\f%\xb7܉֩\xaa\r\x062\xb8\xb2rݹ>\xecy\xbf\rE\x059x\xf4\x81%(-\f\xf3~J\xc9*\xe1\r\x88\x14\xf0\x99\x1f\\\x92\xfc\x89;\x13\x161\xdb\xf6\xc6Q\x0e\x84\xd5\xe5\x05x\xe1\x1f֠\xea\x96|\x0eUF\xe9j{\xd5l[\xb8f\xa5o\xbf\x18\xb4\xbc\xf0\xe3C\x1cb\x84^\x7f\xfb4\x96U\xcd\xc3\xfa\xd5\xe5\x0eDY\xa6\xae\\\x05\xad\xee\b/\x87Y9\x14\xc9x\xc7\xd578\x8a\xcd?<\x88\xba\x86+`\x13\x12|q\xe3J\xfc\xf5\xa7\x96\x8f\xa5\x0fD\xa3c\xfeN\x1c\x13ݳ&\x16\xf9\xe57\x14\x16\xe0\x0e%R\xf8\xe5\xefΡ\xb3{\b\xeb\xef\xae\xc0\x9a\x8f\x94\xd9 + X\xa4\xa7\x88\x81qlz3;\x86\x1baQ\xeb\\(\x124{!X\xa4\x04\xfc\xe0\x17\xc7\xf9\r\xcem\xd5E\xf8\xee\x97?\x86\xca\xf9\xb9~\x7f@p>\xf0$\xc5R\x1f\xec\xfd\x11ל\xb7\x1c\x8e\xba\xaeG\x19X\xd9\xec\x0eK\xf2b|6\aV\xbf5߂~E\xb1\xaf݊\xd2<\xd1ݥ\xf2\xd2<\xbe>\x14k;\x00b\xaa\x81\xcaX\x0e\x9f\x15B|K\xce\xd6H$\x12\x89DʾBs%\xfd\xe1z\xa7;x\x15\x98n\x10\x101\xab=؆M[\x7f\xcf\xf3\x1d\x19\x1c>\xbd\xa5\x96\x0f\x06\xe2\xd1\xccb'\x05B\x99\xff\xe1\xf4\xd0a\xb57]\xe8\xc3\xd6\x1d\x879\xfc\x8a\xe8\xd9s\xaf\x1e\x80\xae\x8f\r\x8eSb\x96\x8fi\xb0p\xcd\n\xdf\xfe5\x7fЏƖ>\xd1\xc5\xd6;\xbf?\xef䉊\xfd\x0fG\x11\xbe\xcd\xf9Θ?\x1b~\xf5\xa4\xefxYdq\xebO\xfe\xc0\xe1\x975\x18&\xb0㗧\xb0\xe7h\xa7\x1d\xf5\x0e/\xaf\x11\x1e\xb2*\x96\xb2!R\x10\x12#\x1a\xfe~K-\xb6\xbfU\x8fw\xf6\xb6\xe0ٗ\xdfî_7\xd8 \x1b\x14\tfee\x9e\x13\xc5\f\x8d\x93\xaf̶u\xf2l7^\xdey\x8c\xc3/kd\xe7\xfa;\xdf?\x00M\tYS\xb1\xe5\xf9\xd2\x1e\xb2\x01\xbf̖\xe6\xc5|\xe7\xd6W\xf6\x9c\x1fW>p8\x8a\xeax\f\x8aןXW\xd3PZ\x10\xc1\xea\x15\xfe<\xf0\r\x0f,\x11E\xa7\x7f8\x8aey\xee\xa7\x03r\x84y\xc6~\xe9\xc9\xc8\xc8\xc8\xc8nX\v\x8d\xdf\xe5&\x8e\xfaJ\xa6\xcc[.\x8a\xc1\nG\xa1\xe9\x06^\xfdy\x1d\f\xe9v\xa2w`\x94\x830+#\xa7\b\x88\x16Z@}\xbb\x0f2\\\x10\x12\x8e\xe2\x7f\xff\xef\xbc˗\x80`\x01\x89l\xc0Ԅ\xd2\n&a\xe1\xaa%\xbe\xfd\xbbܓ\xf0v\xe3\xff:\xaf\f\x8b\xa2\xab\x7f\xa8\xdaʗfn\x96\x95\xc3\b\xe8#\x8e\x97\xa5x\xec\xaf\xeb\x14=lc߁\x80\xcePE\xf6sE\x15E\xe3\xb0ɢ\xcc\xec\xa6\xe2\xadw\x9bq\xb1\xcb\xfd\xf8~\xfb\xff\xd4\xf3\xf3(\xa2\xa6rDW\x94Y\xc4\x17V\x9bR \xa5\x8e\xa41\x06\xd7^\xb1\x01cM\xad}\x1c\xb4ͼ|\xd1\xec\a_-\xe1\xaa^\x8b\xb1=\xacȉ\x06\x9e\x87Ĩ\x86\xef\xff\xfc8>\xf7\xcf{\xf0\xf5\x97\xf6\xa1\xa1\xa5W\xac\xe6\\\x97\x96\x16\xc5s\xf8\xf4e\xcc_e8\xe5\xca\xffe7\x05\xec\xe7[O݃;\x96:\xb3<<\xfe\xf0\xad\xf8\xb3u\x15c}\xe5\xf3\xaf'qK\xae\xdb?\x89D\"\x91Hө\x10E}\xd3G}e3\xfb\xcf:\x83\xa0\x82\xa4'y\xde#\x03%\xaf\x8e\x9e\xb1\x06\x03%\a\x81\xa45*~\xb05\x10zm0\xb0\xfc\t\x1f\xb2\xda.]\xb5\x12F\x13\x806\xec[\x9e\r\x19\x1dm>H)O\xf3X\xbblA0\x84\x1bm\xd61\xb2\xc3i\xb9\xe2DM\xe5c\xb6\xb6\x91\xeeX\xd9\x1c\xb2vN\xf4\xa5\x8e)\xe55\a\xc94U\x98\x83\x830؝\x86\xaa\xa2\xae\xf1\x8a\xa7\a\x83\\\xe0\xf4\xb9\x1eQ\r\x9422\u009c\xf12\xf3\xe7\x8d\x18۩3\x96\xb1\\\xea ]\xe8\xbc\xcaSl\xb8\xbft\xd0;\x85H0ۣKɤ\xefܲ\xe2K\xaf\x1d\xc1\x9b\xbf=\xcbo\x00\xd8\x00\xb6\xaf\xbc\xb8\x17\x17{RN_q\xdeصi\xbdĂ\xf9k\xd7#\xf69\x92\xfb\xb2ԟ\x1f}\xfb~\xfc\xec\xdf\x1e\xc1\xaf^y\x14\xff\xf8Y멂خ\xe4\xb3'i=A \x00&\x00&\x00&\x00&\x00&\x00\xbeY\x01x6\x81\xaf\x90\xd9[\xef@\x8d\xf5\xd8\xdb\x06\b\xcbxjB\x80%Sc\xe0c\xf6Y\x91`\x06\x0e='}\x00b\x03\x86՞\x0e,\x17ηf\x17\xe89.\x9a\xb2.\xed\xd4\t\x1f\xa4,-\xcb\xc5\xedK\x8aD\x17[\x9fZ\xbfX\x14m1\xf8\xd3\x1bN\x89*\xf4\xd3\xc7]\x8f\xd0e\xbf\xac\uf0a2\\\xd1ե[\xa4\xef@\xf6\x97\x15YPi\xf4\xf5\xd9oA\x13)\t^iVډ\x00Z\x19nY\xd9\x1cp\xa6\xfbb\x00̢\xc9r?q݈\xb4\x89\xb2\x92xƛ\ts\xa0_49\xd0+C\xf0\x14$rwž1kﺊ\xda\xf7\xad)\xca,c\xdf\xc5\xee\xdf4\xd9u\xef\xdb܂\xcav\x1f\x967\x1c\x8a\xf0\x9fʒ(\x8a\xe2c\x03\xe8d\x1f\xb2\x1a\x06\x83o\x80&:\xb0\x8f\x00\x98\x00\x98\x00\x98\x00\x98\x00\x98\x00xN\x03\xf0l\x03_[\xc9\x01\x98\xc3\x1dv\x14ώ\xe6Y\xf0\xca\xfe\xb8\xb3\x81?9Q\x7f\x94x\xe5\xadc9\xa1Fw\x9dh\x829r\x05f\xa2KT\x03a\xf8\xc1{\xfc\x8f\xfc\xf3b\x11\xac\xad)\u2e62f\xf7\tќuٰ)A*;\xc6瞾\x17\x15\v\x9dH\xf0g>\xb9\x1c\x0f\xad^\xe8<\x02\x97\xa475\x88\"\xf4\xe6F\xff\xb4[\x16\f1Pd\xd1e\xf1=\xc9zdm\xb5(Bo\x96`l\xaa&\x0f(K\xa5xĕ\xed\x1f\x1b\x8c\x16\xa4\xe5\x8b\xd2\xcfT\xc0\xf6\xdf\xecw\xd2\x05\xcc\xc40`\x186\x04{_\xa3\xcc>\x1fY\xe7\x1c\x97Pɼ\\|d\x11KkQa\xf6\xf7\xf9@=[\x10\xdc8\xe8yj\x10\x8e\xa2\xb1՟\xdb\xcd\xec|\xbb\x03\xf6⼱s\xfd\xc1\xf0Hz\x7f\x9e\xbca1\x90\xce\v\xddB\xacMD\x94\xd3iv\xfe\xa7@\x00L\x00L\x00L\x00L\x00L\x00<E\x00\x9e\x8dQ_\xaf\x8c\xe67Eё\x808#\xc5\xe1\xf7\xa9\xc7\xdds\xfd2\x13mF\xeb\xafE\x137\xa3\xe5mQ\xf4\x89A\xc6ꕥx\xf2\xd3\xcecc\x16\xa4ܺi\x1d\x94h\x98G,\x8d\xd6wĢ\xac+\xb5\xe7\xb7@r\xd0u|\f\xf4\xaaKs\xb0\xf3{\x8f\xe0\xb5\x7f\xf9\x04\x7f\xac\xfd\x95\xbf\xbcÞ\"K\x06[m\xdf^\x98\xc3NT/\xb5\xf7w\x88jC\xae\xb7\x8b\xd9\xfd\xad\xe9Ξ\xfd\xbb\x8f\xba\xe0\xfa\xe15\x8b\xb0\xf1\xe3U|\xf6\bmO\xad\xcb_\xd6\xe0ת\x1b]\x97x\x1a\xc4\x13\x1bj\xf8M\x86\xac\xc7\x1e\\\x8a\x8a\xa2H`\n\x8cx[\x9f\xde\xe9\xce_\xd6/\\p\x96[\x90,\x961[\xf3\xa1\xf9xb\x833H\x90m\xf3ů\xae\xe5\xe7\x96\xfbk\x93^u\xadƲ\x1a\t~\xaf\xbb\x8f\x7f\xa7\xa2\xce\xceCA<82\x1b\xcfU}7h\a{\xfa\xf9\xab\x91\xd3\xfa\xb3\xae\x19\xdb\xf4\xa4\xeb\x1a\x92\xaf\x15v\x9d\xb3\xf5E>1\x89D\"\x91H\xd7C\xaa\xaf\x85\"\xbec\x11\xdf\x00\xe9\xc7^\xe2s\xef\x9ayU\bYi\x10\xf6\x1fs\v\x0e\xfe\xe2\xe1j\x14\xe6G\xf1\x9b\xffoE~<\x82\xcf?Z\xc3S\a\x8c\xe6\xdd0\xbb\xde\x17\xae\xb8\xe9G\xbe\x87\xd0\xe2\rP\xe2\xe5N\xa4\x95\x81\x89\x80&#\x85'7\xdcʧRc9\xb2w.Ɂ\x11\xc9\x1f\x1bL\xd6\xf43\x98W\x8e\nWY\x97\xd1}\x19ï\xfc\a\x7f\x15\xb1}|\x16\xb0\xb0\x0e\xfc\x98XY\x80\x9dԇ\x81s\xe2\xdf7{r\x8a\xdb1\xfc\xea\x7fB\xdd\xf4-g\x0e\xdap\xd4Շ\xc1\xf5\xeb/~\x12\x8d\xe7zQ\\\x98\xc3\x1f\x9d'\x15\x15\x91\xab\x83\x18\xda\xf6\xbc\xe8\x96u\xf8e0\xac\x9d8\x01\xa3\xac\nE\xb10vly\x98\x0f\\\xec\xb82\x84\x87\xee\xad\xc2\xc6\xf5\xa5\xaei\xd2l\x88\xb3\xc0Voi\x81\xd9\xe7NY\xd0\x1a\xce\xf0\xb9\x85\xc5\xdc\xc5b=\x06\xd1\xfc\xae\xd3\f\xe1\xa9\xc7n\xe7\xb3\"\xf4\x0e\x8c\xe0\x8e\x85\x11(\xb1\x1c\xfe\x9d\xe8g\xcf¼zշ\x8f\xb6\xe4\xf2$\xc4\xf2mw\x7fЉ\xcf.*\xb3\xcfÊe\xc5\x1c\xfc\x87\x12\xee\\ܵw\x96\xbb@\xf6\xaa\x19\xc6\xf6\xf3\xed\x81\xfe>WYb\xff\x1e\xc8Q_[\x01ˆu\x03?jv\xa7^\x90H$\x12\x894\xdd\n\xcd\xd2\xfd\x9a\x9d2u\xe8\xfb\xbf\xe9\x1a\xccd\xffa\xb7\xe0\x90E\xb4\xfe\xf4\xbe2l\xfb\xfa}x\xfeK\xabq\xdb\xe2\"\x98\xa3\xdd\xd0\xfe\xf0\x82\xe8\xedH\x1f\x85~\xc0\xfd\x8ad\x91'+\x1e\x1d3\x7flJ\xa9;\x97\x15A\x8b\x8c\xcd\f\xa0$:\xa1\x1d\xde\"V\x996\x8d\xfe\xe2\rh\xf5u\x0e\xb8\x88\xfd\xb3\xf6͎j\n\xa8\xb1\xca\f\x9c\x8d\x0e7$1\x1b\xdd\xf5\x1aB\rg\x9cȨ\xe5S\xa4X\b}xi\x01J\xad\\X\x06ˉ\x1fn\v\xf47)Sc\xc1`i\x18P\x8f\x1e\xe6\xa9%eEQ<\xfb\xe4*\xbc\xf2Ok\xb0q}\x15\xbf\xe9\x90\xe1\xd7\xdeWM\x83\x99H@;\xe3\xe4v\xdb\xd2u\xa4\xea\xeaxT9\xdd@8fe\xc5*V,\xce\x1f\x9b\xf5!\x1c\x8598\x00\xedT\xbd\xb3\x7fި\xaf\x96p\xad?Y{\xb3\xed\x12Z\x87G\xecs\x90\x175\U0004dff9\x1bV*476\x8d\xd9c\xeb\x168\x11\xfbp\x14o\xb4^Do\xc0\x805\xe6\xaf=i\xf8\xae\x05\xb1^`YO\xe2\xf5\x96v\f{\x7f\x9f\b\x80\t\x80\t\x80\t\x80\t\x80\t\x80ot\x00\x9ek\xaf`6:\xf6A;\xfc\x1c\a\x1f\x17\fY\x8f\xf1\xc5c]\xf1\xa3\f\x9e\x87V\xfb\x05 \x15<\xea\xdfh\xab\x85v\xc0z\xe5p\x00$\x88\x17.\xb0\xc8!\x83A\xee\xef\xdd'\xa6m\xf6\a\xaf\rm\xfa\x92\xfd2\v\xd7>\xea\xc9@\xf8\x1d\xf9\xe9\x0e\x8c\xfe\xf7\x7f\x89\xde>\r}\xf3\x19\x18\xc7\x0eÐ\xa2\xc9\xc2'\xfb\xbeT\xebmc\"J\x9c\xdc\xf1\x03\x8c\xee\xde)V\x9f\x9a\xbc\x10\xa9\xc6\\u\xa3\xeb\x12P\x7f\x9cC0\a53\x04D\xa36\xb4z?͡!\xa4\xf6\xbf\xc7\xe1Yl\xc2\xf6\xcd\xfcu\\\x04N\xd7\xf3\\`6E\x9e\xfd\xa67k \x1c;\xaf\xe2&\x87\x9dg\xb3\xbf\x1f\xa9C\x87\xc4\xea\xce\xfe\xc9e\xb9m\x8a\xfa\xd7\xd3\xe7М\xd0\xecs\xf8'\xf7,Ď\xe7?\x81\xa7?\xf3!l~\xe6c\xd8\xf6ջ\xec\x9b.\xd6gׅ\x0e\xbc{\xa9[\xac\xee\xd3\xe6\xfaf\xc7_&\xf0\xb5nxv\xb5w\xa3\xb6+\xf3\xec\x1a$\x12\x89D\"M\x87\x94\xd9\x04\xa13\x9d\x0e1\xfaӊ\t\x7f\x0f\xec-n\xeaC?\x81Rp\xbb3\xba\xdf\x02\t;Rظ\x13\xda\xe1ﺠ(\x9d)\x85K\xa1~\xfc\xc7P\x8aW\xfa|\t\x99g\xb6\xf3\xb4\t\xe8\x99\a\f\xc9\xca\xf9\xc2E%\x1bS\x86Ş\xf9\x1ar\xbf\xf8\xb4\x03\xbb\x80{?;/b\xe8;߀vD\x02\xb8\f\xc6\xfc\x85\xfe\xf6ˈ\x9a\x9a\v\xa0E\x99\xfb\xfb\xf6\xd7\xdc\xf0=\x01\xcd?\xd2\x1cx\xbc#o\xff*\xf3\xf1J\xd1`%?\x1f\x91\x8f\xde\x03\xb3\xb0P,\x1d\xbb\xb9\xb1\xde\xfaƀ\x96\xa5=\xf0ȯ\f\xbfi\xa4\xc4∬\xb9\x0fz<\x1fj8dG\x92}\xfeN\xd5\xf3y\x89\xd9\xd4l\xf6\xfed\xf8\xcc\xfd\xf3\xbfR\xa6:c\x02s\xf0\xf9\xc5\xe5\xd8X\xb1Й\xa1CO:7'\x00.\x8f\xa6\xb0\xad\xa9\x05M\xde\xc1n\x19\xfc=^Vl\x83\xbd|^Y\xb9#e\xe2\xe5\xa6V\x97\xbf\xc0\x03\xf1\xd8[\xf7\xdf=\x91n\x04\xc0\x04\xc0\x04\xc0\x04\xc0\x04\xc0\x04\xc0s\v\x80g\x1a\x84\xaf\x05\x80\x85\x94[\xd6\"\xb4\xf0^(\xa5\xab\xa1\xe4\x96\xc2\xeco\x84q\xf9\b̮C0\xfb\xcexz\x8f#%\x8cP\xd9:(\v\xeev\xfc\xf5\x9c\x84\xd1srr\xfe\xb2\b\xc0\xec_\xa8\xbc\x12\xea\xbdk\xa1֬@x\xd5]0\xfb\a\xa0\x9f\xaa\x83Ƣ\xa6\x87\x0f^\xf3 5\xdbߪ\xbb\xf8\x9b\xe2d\x7f\xa9\xfd\xfb\xf8\xec\f\xa2oV\x008\x00\"]\x11UO=\xb4`>\x94\xe2\x12\x84\x8a\x8b\x80\x9c\x18\x9f\x9a\xcc\xec\xee\x81\xd1\xd7\xeb\xce\xd1\r\x92\xd77\xf3WZ\n\xa5p\x1eB%%@N\xce\xf8\xfe\x84\x0f痢\xdcOo\x9c2\x00\vc\xaf\"\xae)\xc8\xc3\x1d\x05y\xfcMq\x03\x9a\x86\xd3\x03C<M\xe2h\xef\x80k\xd0\xdbt\xf8\v<\x10\x02`\x02`\x02`\x02`\x02`\x02\xe0\x9b\t\x80g\n\x84'\x03\xc0\xb3]\xd9\x04\u0e60\tE\x80= 9n}\xa26]~\xd2(\x9b\x00<\x93R2,#\x00&\x00&\x00&\x00&\x00&\x00\x9e\x0e\x00\x9eՃ\xe0\x18\x98\xcfV8'\xcdA\x89T\x14/\\\x8a\xe8*\x10\xbc|\xa2\n\xf2#\xd7Ǔ\x96\b\u07be\x96\b.\xcfa)\xd2\x0f\x89D\"\x91H\xd7[\xa19\xb0\x8f\x04\xc2\x04\xc2\xd9\x01a5\xe6\aI\xf1\x19\x89\xbb\xeb\xder&e\x82^o=]Y\xf4\rZ&\xfb\x90\xfb\xccQ\x11\xf4\x12\xf4\x12\xf4\x12\xf4\x12\xf4\x12\xf4\xce\x04\xf4\xce9\x00&\x10&\x10\xce\n\bk\t\x7f\x8a\x81\xf8L\r\x8f\xcdi\x1c\x04\x9b\xa2\x9e\xae,\xaf3^=]\xd9\xeb[\x8d\xa5\xaf\xcb\xed\xd71R\x9b\xad\x1f\x12\x89D\"\x91fZ\xa1\x99\x1ctF L |]AX\x8d\xb9?e\x90dml\xa0\x97\x96p\xa2\xc1\x80\xbb\x9e\xae\x1c\xe43\x1d\xc0\n\xc8\x0eZO\xf6-\xaf\xe3\xed+>\xa7Y\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\x04\xab\xb3\x01Vo\xfa\b0\x810\x81\xf0\x94@XK\xb8?e\xc0\x14p\x1a\x89; \x1c\x04Ʀ\xe9o\x97\xd7O\a\xb4\x8a\x12\xbc\x0e\xe0\xf7\x17\x89\xbb\xdb\x01\xffv\b~\t~\t~\t~\t~\t~\t~'\x05\xbfs\x1e\x80\t\x84\t\x84\xaf\t\x84\u0558\xfbS\x86M\x01\xa0r*D&0\xd6\x12\xee~2\xc0\x02\xee\xfe\xa2\x1c\xb4M\xd1\x06\x04\xfbI\xb7\x1d\x82_\x82_\x82_\x82_\x82_\x82_\x82\xdfI\xc1\xaf\v\x80\xe7Z\x1a\x04\x810\x81\xf05\x83\xb0\f\x9aA`\xaa(~\x00M\a\xc6な\xe8\x1f\x89\xbb\xfb\xc9uEI\x1f\x89δ\x9diԜ\xffO\x80\x00\x98\x00\x98\x00\x98\x00\x98\x00\x98\x00\xf8Z\x00\xf8F\x12\x810\x81p \b\xab1wY\x86\xdfH\xdc_\a\xdcuӜ8\xa8\xa6\x03g\xb9\xee\xed\x0f\xf8ۃ\xb6C\xf0K\xf0K\xf0K\xf0K\xf0K\xf0K\xf0;i\xf8\xbda\x01\xd8\v\xc2\x04\xc3\x04\xc3.\x18\xf6¥\x17n\xbd\x10*/\x8fă\xfbxAU\xae\xcb\xfd亷\xbfw\xbdt\xdb!\xf8%\xf8%\xf8%\xf8%\xf8%\xf8%\xf8\x9d4\xfc\xfa\x00\xf8FH\x83\x18\x0f\x86E\x9dt\x13K\x8de\x8e\xd2j\x89\xe0\xa8m\xa6\xf4\x05\xef25\xe6\xaf\x03\xc1˅\x1f\xc0\xdf\xee]_\x8d\x05\x1c\xd0\xd4\xec\x86\xfd\xa5\xbf\xf9\xec\x8f\xec\xddO\x8e\xe44\x14\xc7\xf1\x97V\x95\xaa\xab\x16-\x84\xd4\x1bVH\x1c\x80\x19\xb6\xb3\x80\x13ЃX\xb1\x1a\x8e\xc2Q\x98\v037\x80\x05[\xfe\x1c\x00\x89\x15\x9b\x96Ш\x17S\x94\xa6\xa4\xa0\x14\xed\xe08vb;/\xf5'\xf9\xfeL\xabʎ\xf3\xe2,\"}d\x15\x19\x00\f\x80\x010\x00\x06\xc09\x00&d\x16\xd9o\xfb1\xea\xeb\xbf\x7f\xe7\x9fo\xe3\xd7|\x17i\xf6}\xd72}3W\xa4=\xee^k\xbf\xf5\xdc\x10\xf8\x05\xbf\xe0\x17\xfc\x82_\xf0\v~c\xf1\xeb\x05\xf0\x94w\x81\xa7|o$!\uebadH\xf3\xe7\r]}\xdf\xfc\xb2l\x1e7\x88]n\xda\xc7D\xfcs\xbb\xc0\xeb\x1eW\n\x0f\xc3\xcc\x1f\x86\xc3\xc3\x00\x80\x010\x00\x06\xc0\x00\xf8\x00`\xa0\b\x14k(N\xb5\x85\x00j\xc3\xd3\xed/7q\xc7};\xc6ˍ\x7f\xae\xbb\x8e\x10x\xdd\xe3\xe0\x17\xfc\x82_\xf0\v~\xc1/\xf8\xcd\xc6o\x10\xc0\xec\xfe\xb2\xfb;\xd9\xdd_\x1b\x95.0mx\xba\xfd\xf7\xef\xfa\x8f\xdb\xe3>\b\xdbs};\xc4\xeez|\xd7Y\xac=7\x94\xd6x\x10x\x10\xfe{\x10\x000\x00\x06\xc0\x00\x18\x00\xb7\x00<%0\x82_\xf0[\xe3W\xa4\x8dK\x1f<s\xfa\xbe\xf1\xaes}\x10\xb6\xd7\x13\xda!\x1e\x90\xa2\xd1\x03\xc0\x00\x18\x00\x03`\x00\f\x80\x01p\x03\xc0S\x81#\xf8\x05\xbf\r\xfc\x1a\\\x9aO\x1b\x97f\xcc\aј\xbeo܆\xf6b\xdd\xee\xbb;\xc4\xf6zB;ę)\x1a=\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00{\x01L\xa3M\xb2\xed\xb7\xcd\xef\xcbMs܅hl\xdf7.\xe2\x1f/\xcb6\x84\xf7\xdb\xfe\x9fJ\x14Es\xfd\xe0\x17\xfc\x82_\xf0\v~\xc1/\xf8M\xc2o\x14\x80\xab\x1d\xd4K\xddEe\xf7\x97\xdd\xdf\xd6\xee\xaf\x01\xa8\x0f\xa3ˍ\x1fƱ}_}\xdfxQ\xb4\xaf\xedBX$\xbcCl\x80\x1c\x19\x1e\x02\x1e\x82\xf6C@\b!\xf3\xce\xc2|\xe9K\x85\xc9K\xfa\x87$\xc6\xc0\xef\xea\xc5_\aK\xec^~T\x9e\xb2\x86f\x9dP>\xfc\xe5\x8f\xc3:\xff\xfe\xec\x93\xf2\xd4uL\r\x8d\xf5\xd4(5\xbb\xaa\x8f\xfd믿9\xac\xf3\x9f7\xaf\xcb\x16N\x17\xeb\xa8\xfe\xf5\xdd\xf3\xffk\x18\xa4\x9a\xcf\xd0y\ued56\x9b\xfe\xb5$4\xdfC\xf0ó\xa7\x87\xe1\xaf~\xfe\xb5\xf4\x1d\x8f\x8dF\x1dSCc=\xec\x00\xb3\x03\xcc\x0e0;\xc0\xec\x00\xb3\x03\xac\xb6\x03<6*/\r\xbf\xee\xf7c\xd7Ь\x13\x03N\xfb\xfb)\xea\xb8\xe7\xe5\xd6i\xc4\x06\xa5H\r\xce\xea?\x83\xd8\xc6.\xacHs\x97\xd6ӯ\xcf\xf3\xd50u\xcc9vߩu\xfd\xe5]\xb8\x8e}\xed\x88\x14N\xdf\x05\xe7\xabgO\x8bR\x01\xae\xb9u\xec\x1aC\xd7\x03\x80\x010\x00\x06\xc0\x00\x18\x00\x8f\x06\xe0K@\xf0\xd8\xf8\xed\x1a\x1b\xbb\x86f\x9dXp\xe6\xa2S\xa3Nh~j\x9dV,P\xd6ȴ\xdaa\xccŦ\x83f\xbb?\xa8\x865\x9eTG\x01\x9c\xb9\xe8Ԩ\xe3\xabA\b!\x84\x1c+W\xe7\x82̡m\xac\xdf*w\xe1R\x03\x9e)5\xba\xe6\xa6\xd4I\x05g*:\xb5\xea\xe4^\xa37\x8bu\x10\xae\xa6\xd5\xc7\xec\x9dZ\xd37\x18-\x8a\xc6\xeeqV\x8d\xc7\xcfzn_\x1d\xfb<%p\xa6\xe0U\xa3\x8e\xd6Z\x000\x00\x06\xc0\x00\x18\x00\x03\xe0\xa3\x02xLp\xe6d\xacuĠRcα\xae3\x14\x94Zsb\xa2U\xc7\x1b\xeb7\xbfQ\xcd\aO\xb3\x9bۓN\xbc.\xd6\xd1k\xa9\xebص\x14К\x02Ϙ:\x1a\x01\xc1 \x18\x04\x83`\x10\f\x82\xc7F\xf0\xd5\xc0\xf3O\xba\x1b<&\u0087`2\xa7N\u05fc\xd8\x1a\xa9ss\xb0\xd957\xb6N\xdf<\xad:\xa1\xc4\xe2\xb7F\xe7~\xdb\x00\xab\x19k\xa04\xa6\x8e[#a-\xde\xf5(\xe17\x06\x9e\xb1u\xfa\xf0\xaaU\a\x00\x03`\x00\f\x80\x010\x00>)\x80ǆ\xe8)\xae\x97\x8a\xc8\xd0|\x8d:\xa95R\xcf\xc9A\xa4\xef\x9c\xd4:\xa1\xf9Zuz\x11\x19\xd9Z\xbf\xc1]n\x92\xe1\x1aBp\xeaZZ\xeb\xe9i\xb1\xd8\xec\x83gj\x9d\x10^\xb5\xea\x00`\x00\f\x80\x010\x00\x06\xc0g\x01`\x17\xa6c\xe1t\xcc\xda9x\xec:O\xa3Nn\x8d\xd8sS\xf1\x18:7\xb7\x8e{\x9eV\x9d(\x84&\xb4\xfa\xbc\x8e\xffY-\xa9N\x06\xa0CuBy\x95\x88\xcd\x10<S\xd1ꫡQ\xa7l\x1f\xa2\xd1h4\x1a\xed\xbc[\xf5\xfe\xe0\xdc?S\xe3R\xa2\xf5^ޱ\xde\xef\xab\x1d\x8d\xf7\xf2\xaa\xbc\xdbW\xb1N\xa8\xd5\xef\xe5\x1dЪ\x1a\x1au\x8e\x91꽼\xe6\xfb)kh\xd6!\x84\x10B\b!\x84\x10B\b\x99]\xaeF\xa8I\b!\x84\x10B\xc8\xd9f\x918\x7fPv\xf77\x9f\x8a\xc8\a\"\xf2\xe4\xf1\xd3noE\xe47\x11y\xbb\xba}\xf8\xdd\fN)\xdc\xff\xbc\xef\x9f\x10B\b!\xe7\x91b\xc0\xb9\xbd\xd9\xdd\xdf܉\xc8\x17\x8f\x7fO\x9c\xc3}\xa90\xfcc\xf5\xb7\xba}xc\x06/)\xdc\xff\xbc\xef\x1f\x00\x03`\x00\f\x80\x010\x00\x06\xc03\x01\xf0\xee\xfe\xe6s\x11\xf9VD\x9e{v\xf9r[\xb5;\xfcZD\xbe_\xdd>\xfcd\x06\xcf1\xdc\xff\xbc\xef\x1f\x00\x03`\x00\f\x80\x010\x00\x06\xc03\x02\xf0\xee\xfe慈|'\"\x1f\x9b\xb1\x91\xf2gu\x9d\xd5\xed\xc3K3p\x0e\xe1\xfe\xe7}\xff\x84\x10B\x8e\x92\x7fٻc\xe3&\x82(\x8c\xe3ǌg\x94\x1d&Pn*\xa0\x04\xdc\x01.\xc1\xae\x00J\xa0\x04:\xb0\xe9\xc0t`:\x80\n0\xf1\x05\xd8\xca.\x82\xb9\x99]\x861\xc8\xd6I\xa7cW\xef\xf7\x96@\xb7\x91>\x9c\xfc\xfd\xe9\xafg\x00\f\x80\xcb\x00\xe0\xd4\xf8]\xcd\x00>\x0f\xcf\x00\xc2\xe7\xff\xbb\x11\x94?v~\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00\a\x02\xe0\xbek\x9f'\xf09\x9b\x19|\x1e\xceu\x02\xa1\xfb|1\xc7\xc8\x1f;?\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x83\x01pj\xfd\xae't<w\x9d\xc1\x11>\x9b\xab\r\x94?v~{\x80\xed\x01\xb6\a\xd8\x1e`{\x80\xed\x01\xb6\a8\xd8\x1e\xe0\xbekߦo\xe7\x97\x02?Mz/7\xe9\xbd\xed\xf5\xc8\x1f;\xbf\x06X\x03\xac\x01\xd6\x00k\x805\xc0\x1a\xe0`\rpߵ\x97\xe9\x1b\xfe%\x9faS\xc4E~\x98r䏝_\x03\xac\x01\xd6\x00k\x805\xc0\x1a`\rp\xb0\x06\xb8\x12\xf8\x19\xfe\x9d\xa7\x8d\x04\x11\xe1/翔\x7f\xda\xfc\x00\x18\x00\x03`\x00\f\x80\x010\x00\x0e\x06\xc0\x15\xc1On\x00']\x91UY\xfe\xc9!0z~\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00\a\x03\xe0\xe4U\xd6\x02?\x93\x7f\xfc]Y\xfe?\xcfyz\xef\xf2\uf41f\x03\xcc\x01\xe6\x00s\x809\xc0\x1c`\x0ep0\a8}\xdb\xff\xe6\xc1u$\xf8\xad)\xff\xba9\xddv;B\xf4\xfc\x00\x18\x00\x03`\x00\f\x80\x010\x00\x0e\x06\xc0i\xcf\xebma\xdf\xf6\x9f\x13~k\xca\xff\xd8\f+\xe2N\xc6\xeeɍ\x9e\x9f\x02A\x81\xa0@P (\x10\x14\b\nDL\x05\xe2**\xfcV\x96\xff\xa9s\x9c\xb2\xc8?.\xbf\x06X\x03\xac\x01\xd6\x00k\x805\xc0\x1a\xe0H\rpE\x1f}\xef\x05~\x0f\xe4\xa3\xff\xadU\x80\xe8\xf95\xc0\x1a`\r\xb0\x06X\x03\xac\x01\xd6\x00\xc7l\x80\xaf\xa2\xc2oE\xf9\xf7\x99)z~\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00G\x02\xe0\xb4C\xf7$?G\x83\xdfJ\xf2osNR6\xf9\x1f\xc9O\x81\xa0@P (\x10\x14\b\n\x04\x05\"\xa0\x02\xd1w\xed\xb7\xc2\x01h\x9f\xcdo\r\xf9w\x99\xdb\xc5r\xf52?\xc8\xffw~\r\xb0\x06X\x03\xac\x01\xd6\x00k\x805\xc0\xc1\x1a\xe0\xe4~F\x86\xdf\xd2\xf3\xef2wOy\xbd\a\x9e?\xb7\xc0\xaf\x010\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x7f\x03p\xe1\x7f\xf0`\xaf\xf0[A\xfemϰ\xca\xed]Z\x05v\x110\x7fČ\x14\b\n\x04\x05\x82\x02A\x81\xa0@P 6U \xfa\xae\xfdQ\xe8\xea\xab9\xe0\xb7\xe4\xfc\xdb\xccu\xd34\x1f\xc6l>8\xb0\xfc\xeb\xce\xddb\xb9z\x91\x1f\x8c1\xc6\x18\x13o\x8e\xf2\x8b\xbek\xdf\x04\x87\xdfR\xf3\x8f\x99۴\xed`\xf8\x85\xe1{\xbe\f\x94\x7f\x93s<d],W\x9f\xf2\x05\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x83\x02\xf0\xb0'5\xbf\x88\x06\xbf\x05\xe7\xdf\xf4ܤ\xff\xab\x8f\xf9\"X\xfe\xb1\xe7\xb4i\x1a\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00\x03\xe0\xe2\x00xN\xf8-1\xffSs\x974\x87\xf7c\xdb\xde\x03\xc9/\xebfY9\xc0\x1c`\x0e0\a\x98\x03\xcc\x01\xe6\x00\xafs\x80\xfb\xae\xfd\x99_\a\x84\xdf\xd2\xf2?6_\x06\xb7w\x80\xdf\xc5ru\x9f/\x03\xe5\x9f\xe4,\x96\xabg\xff\xb86\xc6\x18cL\x809J\xf0\xf3*_\x04\x85ߒ\xf2\xaf\x9b\xec\xf6~\x0e\x9a\x7f\xd2\x19~\xe6\x8b\xe5\xeak~\xa6@P (\x10\x14\b\n\x04\x05\x82\x02\x11O\x818.\x13~\x7f\xb1w?7mla\x18Əذ\x1b\xb8\v\xd6p;\xa0\x83{K\xa0\x83K\a\x97\x0e\x92\x0e\x92\x0eB:p*\bt@\a8\xebYİ\x9b]4\xd2\x19\xc9J\xf83\x18\xcf\xf8\x1c\x7f\xbf\xf3-\x12ے\xa5\x17o\x1e=\xf3\xce|\xb3\xfd\xe7\xb8\xf0\x9b\xda>o\xd3\xf6V\x94?ɼ\xd5\xcc\x00\x18\x00\x03`\x00\f\x80\x010\x00^\x03\xe0\xf3\xfcoD\xf8-%\xff\xfa\xb9\xc9\xd0\xfb-h\xfe9N\x9f\xf9v\xa2\xef\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x01p\x05\x00|\x1c\x18~K\xb1\x81\xab5\xdb\xfbc{_[M\xfe$\xf3\xe4\x99\x010\x00\x06\xc0\x00\x18\x00\x03`\x00\xbc\x06\xc0\x91\xe1w\xd7\xe7.C\xef\xd7\xf7\x7f\x951\xc6\x18c\x8cym\x0e\x9e~\xdb\x18c\x8c1Ƙ\xfd\x9c\x12\x00\xf8\xb2k\x9b/Ë\x80\xa7\xef\xa2^\xf7k\x88\xbb\xb6\xf9Ե\xcd\xe9\xf0\x01\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x010\x00\x9e\x0e\x80W\xc1!xUH'\xf5*\xa5\xb4\xec\xda\xe6{^M\x1c)\x7f\xc4\xdf\x1c\x00\x03`\x00\f\x80\x010\x00\x06\xc0;\x04\xe0\xbb\xe0&\xb8\x84\xfc\xeb\xa7\xdfT\xb6\xe8\xda\xe6\xbek\x9b\x0f]\xdb\x1c\r\x1f\x04\xc9\x1f\xf17\a\xc0\x00\x18\x00\x03`\x00\f\x80\x01\xf0\xcc\x00\\\x8a\r\xdb\x15\x04\x97j\x03\xcfRJ\x1f\xfbߧ\xff\xbbtm\xf3\xcf\xf0A\x90\xfc\x11\x7fs\xab\x90\xadB\xb6\n\xd9*d\xab\x90\xadB\xb6\n\xd9*d\xab\x90\xadB\xb6\n\xd9*d\xab\x90\xadB\xb6\n\xd9*d\xab\x90\xadB\xde\xde*\xe4\x83\xdf\xc0*\x056\xc1\xb5\\\x12?\xcf\xcf\v^f+|\x1a,\xbf\xaco\xcb\n\x80\x010\x00\x06\xc0\x00\x18\x00\x03\xe0\x17\x00\xf8\xa6 \x00\xde\x05\x04\x97\x96\xff\xb5\xd3\xdf4w\xb9v\xd3\xdc\x7f\xc3\aA\xf2\xcb:.+\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80+\x02\xe0\xb9!\xb8f(\xfa7?Jm\xb8i\xee4X~Y\x9fϪ\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xfc\\\a8\xf7@\x7ff\xb3X\xda\xcc\xd2\t.8\xff&\xb3\xc8\x1b\xe6n\x877\x82\xe5\x7fnV\x87'\x8f\x7f\r/\x18`\x06\x98\x01f\x80\x19`\x06\x98\x01\x8em\x80\ahJ\x81Mp\xa9\xf979\x17\xbd\xd5\xcfV\xf8\xff\x91\x8fRۧ\xfc\x913\x02`\x00\f\x80\x010\x00\x06\xc0\x00\xf8\r\x00|](\x00\xcf\x05\xc1%\xe7\xdf\xf4\x9c\xe5\xa7F,G\xfc\xfd\xf61\x7fČ*\x10*\x10*\x10*\x10*\x10*\x10*\x10c+\x10\xf92\xf8}\x86\xa6Rg\xd2:D\x05\xf9\xdf3\xcbÓǿ\x87\x17\xf2\xff\x99\x9f\x01f\x80\x19`\x06\x98\x01f\x80\x19\xe0x\x068\xe5\xc5\v)\xb0\t.=\xff\xd4٢\xe7g\x80\x19`\x06\x98\x01f\x80\x19`\x068\x9a\x01\xae\xc8\x02Nf\x82\xf7Ԃ\x8e\xb6\x9f\xd1\xf33\xc0\f0\x03\xcc\x003\xc0\f0\x03\x1c\xcf\x00\xa7\xfc|\xd9\x14\xd8\x04א\x7f\xcaL\xd1\xf3\x03`\x00\f\x80\x010\x00\x06\xc0\x008\x1a\x00\xe7Gg-\xa2BpE\xf9\xc7\xce\"g\x92\x7fD~\x15\b\x15\b\x15\b\x15\b\x15\b\x15\b\x15\x88\x80\x15\x88|\x19\xfc\xa8\xbfl\\\xc9sa\xb7^\x87\xa8,\xffK\xb3\xea\xeb\f\x87'\x8f\x0f\xc3\x1b\U000bf79f\x01f\x80\x19`\x06\x98\x01f\x80\x19\xe0`\x068[\xc0\x87\xfc,\xd9\x14\xd1\x04W\x96\xff\xa5\xb9\xc8Y\xe4\x7fC~\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00\a\x04\xe0\fA\xfde\xe3\xab\xe1u@\b\xae)\xffS\xe7*g\x90\x7f\x83\xfc\xf3\xcd/\xf6\xee^5\xca \n\xe3\xf8\x1b\r\xa6\xdbfIag!\x82\x88\x9a\xd2N\x1bk\xb1\x13\x11\xd4\xde\xc2K؋\xf0\x02\"\x88ةw`\xa9\x9d\x1f\x88\x8d\x85\x9d\xc5\",\xe9\xd6&22\x03\xcb\x12\xcd\xfbqf\xe6\xcc9\xffg\bl\x16d\xe7\xb1\b?N\xcenX\x81`\x05\x82\x15\bV X\x81`\x05\x82\x15\b\x15+\x10\x9b'²\x957\x11\xe5X\x87h\xa9\xbf\xf8\xff\x83\xf7\xfeL\x80\x99\x003\x01f\x02\xcc\x04\x98\t0\x13`G\x13\xe0t\"$Z\xf9\vZa\x1d\xe2a\xfaF\"\x8d\xf5\x17ǟ\xf7\xfe\x00\x18\x00\x03`\x00\f\x80\x010\x00v\b\xe0\xc6\x10\x14\xf0\xf7<}#\x95\xc6\xfa?\xa6\xbfl\x7f\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00;\x04\xf0\x06\x824\xef\x84>͉\x1f\xfa\xfb\xee\xcf\x0e0;\xc0\xec\x00\xb3\x03\xcc\x0e0;\xc0\xec\x00;\xda\x01\xde>\xeb\xe5\xecf\xfc\x9cX-\x1f\x91\xb5\x8a\xef\xf6/\xf2\x86'\xfa\xfb\xee\xcf\x04\x98\t0\x13`&\xc0L\x80\x99\x003\x01v4\x01N'B\xe3BDP\xed\xf3&~\xcek1\xfc\xd0\xdfw\x7f&\xc0L\x80\x99\x003\x01f\x02\xcc\x04\x98\t\xb0\xc3\t\xf0\t\xd3\xc0\xc3\b\xa2\x92\xf9\x11>\x99\xa06|\xe8\xef\xbb?\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x1d\x028\x9d\xf8\xc9\v\x8b\x02\x10\n\xf0]\xe4x\xa3\x1b\xfd\xe9?\xb6?\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x1d\x02xk\"\xf8(\xfe\x151\xa9\x1d\xd1U\xfcU\xf7\xa1\xf6_u\xd3\xdfw\x7f\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00;\x04\xf0\xe6Y/gw\xba\xae\xbb\x15\xbf\x0e\xd2\xf3=\xf3\xb1\xeb\xbaw\xe1ko\xff\xe8mz\xb2\xa5\xd0\xdfw\x7f\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00;\x04\xf0\xf6Y/g\xd7\xe3T\xf0\xe0\x84\xe9\xe0*\xa2g\xb5\xb7\x7f\xf4)=i)\xf4\xf7ݟ\x10B\b!\x84\x10B\b!\x84\x90\xe2ٽt\xfbCzL\b!\x84\xfcͷW\x17\x8f/\xdf\xfb\xbeS᥋$\xf4;;\x9f\x9b\xed\xc7\xe1p\xfe\x7fv\xd3\x03\xc9|yp\xf78=\ue6eb/^7\xf3\x83\xc8z\xbf\xf7\xbf\xee\x0f\xeewc\xfe\x92~J\xfa}~\xf2sp\xbfk\xcf\xce\xd3OI\xbf+\x8bs\x83\xfb}]\xfc\xa6\x9f\x92~\x1c\x0e\xa7\x8d\xb3#5\x01\x1e\x83\u0096\xb0h\xbd\xdf\x18\x14\xb6\x04F\xeb\xfdƠ\xb0%,Z\xef7\x06\x85\xb9\xc1(9\x01\xd6ڏ\t0\x13`&\xc0~'\xc0\x93\x01,\tC\x8dP\xb4\xdeO\x1a\x86ڠh\xbd\x9f$\f5B\xd1z?i\x18JBQ\x02\xc0\xda\xfb\x01`\x00\f\x80\x01\xf0`\x00無\x06(Z\xef\x97\x1b\x86\xb5\x91h\xbd_N\x18j\x80\xa2\xf5~\xb9a(\x81\xc4)\x00n\xa5\x1f\x00\x06\xc0\x00\x18\x00\xf7\x06pI\x18ր\xa2\xf5~\xa5qX\x03\x8a\x96\xfb\x95\x84a\r(Z\xefW\x1a\x87S\xa08\x16\xc0-\xf5\x03\xc0\x00\x18\x00\xfb\x05\xf0\x99\xf4@;\x0eK\xbc\xbe\xf5~\x01\x86\xb5pX\x02\xa6\xd6\xfb\xd5\xc4a\x89\u05f7\xde/\xc0\xb0\x16\x0eK\xc0\xd4z?\x00\f\x80\x01\xb0S\x00\xd7\xc6a\xee{X\xefW\x13\x86%\xeea\xbd_m\x1c澇\xf5~Zp\x96\xeb\x1e\xd6\xfb\xb1\x02\xc1\n\x04+\x10NW \xb4\xe00\u05fa\x80\xf5~Zp\x98ke\xc0r?-0̵.`\xbd\x9ff\x94\x9d\xb62\xd0w\x05\xa2\xe5~\xac@\xb0\x02\xc1\n\x04+\x10\xff\\\x81ЈC\xc9{Y\xef\xa7\x15\x87R\xb1\xdeOk\xb4\xc2U[?\xad8\x94\x8a\xf5~\x00\x18\x00\x03`\xa7\x00֊C\xa9\xfbY\xef\xa7\x1d\x87S\xefg\xbd\x9fvdN\xbd\x9f\xf5~\xdaq8\xf5~\xc3\xff\xfd\x1f\xf6\xae\x04\xba\x8a*M\x7f\xb5\xbc\n/\x1bI \x10 \t\x84E\x10\x05\x81\xb6\x15\x90c\xbb;\xdd.C{\x9cn\x8fmw\xdb=jw\xcf\xd8*N\xe3AG\xdb\xd1q\xecVf\x18\x97\xd6\xe3\xe9#j;n\xe3qz9\xed\xf1\x8c\xa3\xe2\xd8θ\xa2\x10\x10!@  KX\xb2\x11\x92\x97\xbcT\xbd\x9a\xf3\xdf\xd4_\xcb{\xf5\x92GB;\xa4\xde\xfdnr\xea֭[\xff\xab\xef\xe5\xe6ޯ\xfe\xfb\u05ed\x91\xc5O\n`)\x80\xa5\x00\xceS\x01|\xa2\x8b\xc3\xe1^g\xd4\xf9\x8d\x14\x9c\xe8\"\xf6\xff\x8b߉.\x0e\x87{\x9dQ\xe77R\x10u\x91(E\xb0\x14\xc1R\x04K\x11\x9cM\x04\xab\xf9(\xba\xa2\xceo\xa4\tˡ\\k\x94\xf9E]tE\x9d\xdfH\x13^C\xb9֨\xf3\x93\x02X\n`)\x80\xf3T\x00KA{b\t\xdac\xe57\x92\xc4\xe1\x97!(%\xfe\xbc\x90\x82=(أ.\xb8\xa4\xa0\x94\x82R\nJ)(\xc3\x04\xe5\x88\x17\xc0RL\x06Ťė\x03)\xda\xfbE\xbb\x14\x93A1)\xf1\xe5@\x8av)ڥh\x97\xa2=\xdfD\xbb*\x85\xed\xc8\x10\xb6\xb9\xf2\x1b\xc9B2\x97k\x8f:?)lOLa\x9b+?)$\xa5\x90\x94BR\n\xc9|\x13\x92\x91\x10\xc0#]D\x0ev\xfdQ\xe7'1\xb21\xd2E\xe4`\xd7\x1fu~Rܞ\xd8\xe2V\x8aw)ޥx\x97\xe2\xdd/\xdeu\xce\x1c\x13\x14\rJ\xf9,(c\x17@\xad\\\x00\x14M\x82ݲ\x11v\xcbz\xd8\a\xd7\xc2\xee\xde\xcf5\x87n\xefp=\xec\xd6\rC\xb37\\\x8cP~\xc7\xe2aT\xca+\xa0ϙ\a\xfd\xd4y\xd0fσ\xdd\xd1\x02\xf3\xb3zX\x9f\xad\x87\xb9e\x13\xd0\xd7\xc7Us\x82\xb07w~\xbf\xbd\x93O\x1b\xb2=\xe2\x90\xed\x05\x12Q\xe7\x973\x14\x05\xca\xe8R\xa8eeP\xca*\xa0\xc4\xe3\xb0;:\x90jk\x83\xdd\xde\x06;\x91\xe0\x9a9C)\x1b\x9di\xaf\xb5El\x87b/\x1f\xf9\x1d\x8b\xc0*\x8b\xc50\xb3\xa4\x10ӊ\v1\xa3\xb8\x10\xed}&\xb6vva{W7\x1a\x8f&\x90\xb2s6\x15\xb07\x83l\x16\xf9\xec\x1d\xedFcױ\xdb\xcbG~R\x00K\x01,\x05p\x9e\n\xe0\\\xbd\x8bJ\xd1$\xe8K\x1e\x812~\xa1\xe8TU\xcbBJӠ\x8c_\fMQ\x00ӄ\xb5\xe5װ\xd6?\b\xa4\x06\x17\x05JqM\xbf\xbdqgp\x91\xb0K\xf6\x14@ط6?\x0ek\xdd\x03|\xf8\xcf\xea=\x1d\xa9\xfcr\x86\xa2\xa0\xe0\xdb\xdfE\xe1\xcd+\x00\xc3\xe0R\xc0J\xc2\xf8\x8b\xcb(\ak\xfbvt\xad\xb8\x11\xd6\ue740eq\x8dp\x90\xbd\xab\xbe\x8f\u009bn\v\xb7g%a5\xee@\xd7\x1d\xb7\xc0ڹ\x9d\x8fJ~i\xfcr\xf5.*\xc5\xc5\xd0\xe7͇ZQ!\xfeOT\xc5\xd1ҕ\x95\xd0hk\x9a0\xb7m\x85\xb5m\x1b`&\x00=>\xe0V)\x19\x03\xfd+_\x11\xf6\xf83؞bO\x13\xff\x7ffC\x03\xac\xc6\xed\x03\xda\x11\x9f\x93\xc7\xfcrM\xf4\u05fa|\xe28|\xa7\xa6\n\xaaJ{p9~\xad\xb2\\lww\xf7`e\xc3N\xecM\xf4\xf2i9\xd9\xd3a\x02\x9a!\xda$\xb4\x12\xd7\xdeή\x04Vmm\xca\xc9\x1e\x89\xdc\xc1ޠ\x96\xeb\xf5D\x91\x9f|\x15\xb2|\x15\xb2|\x15r\x04_\x85\x9c\x8b@T\xa7\\\x06}\xe1J\xc0(\xe1\xa2\x00x\xc0\xa2\xad\xd2R\x0f\xf3\x7fn\x84}d'\x1f\u0380Zs1\xf4\xb3\x1e\x0e\xd8\v\fz\xce>\xe5\x94Cka\xbe\xfb\xb7\xb0\xbb\xf6\xf2\xa1cz\x8dp\xd4\xf9\xe5\xe2\x1d%/f\xe1\xcf\x1f\x84q\xf69\\\xe4\x8a91\xb0\xf8\xf3V\x12\x89G\xfe\x19=\xcf=ŵ\xb2\xdb;k\xb1o`\xf2m\xfd)\x99D\xf7ÿD\xefK\xcfrIV\x84yH\xa3\xce/\x17\x81\xa8U\xd7@?\xf5T@U\x01]\x0f\xb6'\xd3\x14e\xbc\x9fjm\x85\xf9\xc9\xdaL\xef\xa6O̩\x13&\"6\x7f\xbek\x8b\x93kӗR\x87\x0e\xc1\\\xbfn@{\x9c\x0f{\x8dp\xd4\xf9\xe5\xe2\x1d\xad0b\xb8iF-\xe6\x8e\x0e\xe9_|m+\xa5\xc6`\xda6~Ӵ\x0f\xff\xd9|\x18v\xae\xf6\x9csw\xed\xeb\xc4\xe8b\x03\x15Ūk3\xa9\xe8x\xbai\x1f\xfek\x00{\x9cX \xfa_\x85\x1cU~\xf2U\xc8\xf2U\xc8\xf2U\xc8\xf2U\xc8Y_\x85\xccI\x19\xbf\b\xfa\xd9O\xf4\x8bU+\xe9vH\xfe\xad\xea\xf3\xa6)c\xe7A?\xffy@+\xe0\xa2\x00\xc8#\xaa\x9f\xfb\x94'\x0e}6h\x80\xa2\x01\x8f\a*\xfa\xb5+O\x87~\xe1K\"\x9c\x80m\x1cOD\x9d\x1fM+\x17\xaf|,7qHI3\x10_v\a\x8c˯\xe4\x9a\x19\x10\xf6\xceZ\x1c8'\xc36o\r\x03\x85\xcb\x7f>\xa0=\xc9/;?\xb5\xb2\x12\xfa\x82\x05\xe1\xe2\xd0\an\xa3ji)bg\x9c\xe9\t7\x9f\x80c{\xae8t\xda\"ߌ\xb9\xed\xdc\xc7O\xd4\x1f\xc0\x9e\xf8\xf1\xe7%\xbf ?E\xc1\xed\xb3\xea\xfaů\x95\xcc\xf8|j[\xf4\xfd\x93\xc0\xa3\"CUq\xfd\xe4qX2\xb6<͒\xd3܁~{\xc5^\xff\xd3\xdcf⺻\xdf\xc2\x0f\xeez\x13W,{\r\x0f\xbd\xf8\xb9\xe0N\xb6\xc9ޏ\xa6Vg\xb57\xdc\x14u~\x12\x12\x12у\x9a\x93wT+\x80\xbe\xe8\xc1P\x11\xc1U\xc2\x06)\xa5d2\xb4y\xb7qq\x00\xfa\xe2\x7f\xc9\xe8 )K\xe1\x06ԙ\xd2\xc0̞\x1f\x1e\b\x95\xc2Zh\U000d6cc9\x9c\x11u~\xb9\xa0`鷠\xcf\xff*\xeffrM\xcf;\xdb\xc2[\xef\x80:\xa6\x92\xcfpQ\xf0\xcdoC\x9f{Z\xe89n\x99f\x04Ρ\xef\xa1\xf0\xb6[\x85瘋\u0090\x8b\xb77J\xfc\x06\xf5\x8ej\x9a\b\v\xa0v\xc2^P\x16\x1d\x9cπ\xae\xc3.-\x85v\xd2LO\xb8\xf9\x04\x9c>g\x8e'4\x9d6\xe9\xda\xe3r5\x16\xd8*EEY\xed\r\x94\xa2\xce/\x17|\xa3j\xac\x88\xf7\x1d\xa8\xfd\xa8\xa9\xbe\xfe\xbf\x03\x87Vi\x06\xae\x9fZ\x8dB-\xf3\xa6\xf8\x92\t\x95\x98\x16\xd7=\xaf\xaam\xe3\xc1\xa7?\xc1\x8e=G\xb8\n~\xbff\a^{\x7f\x8f\xdb6\x89;\xd9\xd3\xd3n*\x86\xe2\xcd\xce7~\xd2\x03,=\xc0\xd2\x03\x9c\xa7\x1e`m\xee-\xb0K\xea\xdcN+ \x06|\x03HX\xd2f\xfd\x10J\xc5)\xbc+\x926\xe7&(\xa5S3:H\x16\x82\x9c\xf7\x0f^<pi3\x7f\x04\xa5l\x16\x9f24\xc1\x9bg\xfcH\xe0\xc5oY\xc1\xbb\x19^\x99\xdd͝\xe8\xecQ<\xde|ݚ\x01\xa5\xa4\x14\xf1\xe5we\x86\x06,_\x1e\xfe\xbd\x84|\x7fl\x8f\xeaۣ*Q\xf8\xb3\xa0\xbd\xe1\"\xea\xfc\xf4\x99\xb3ăi|\xe3\xc5[?R,\"\x1c\x8f'\vH}\xfat(\xf1\u0080gS\x9f}\n\x94Q\xf1@\x9b\xe4\xf6\xc83\x14\xfe\xb6\xea\xff\xbc\x80=\x9fM\xbf\xfdA\x05o\x9e\xf1\xa3\xa9\xfck&O\x10\x9f9P\xfbIocT\xbfX\xb1p픉|D\xa4r#\x86\x1fN*\xf5ڧf\xa0\xa5\xbd\a\x9fn>\xc4U\\\xbc\xfe\xden\xf7\x7f\x81\n\n5\x157L\xad\xe6\xc3\xc7\x05Q\xe7'\x05\xb0\x14\xc0R\x00\xe7\xb1\x00V\xeb\xbe\x19\x18D2:\xb4\x81\x92f@\xad\xbb\x82\xf7DRg\\\x1d\xdaA\x92g\x80\a'*\xe83S\xa2c\xe7\x01\x8f\xcaS\xb1\x18Ժ\xa5l\xea\xb8`\xa4\xf3\x1b\xccc\x1a;\xf7B(\xc5N(\x06_\x97f\bax\xed\x9do\xe2{w\xbc\x81\xcb~\xfaG<\xf3j\xa3;0\xf9\xaf\xdf8\xef\x02 \xe6\fF4={΅\x80\xee\x13\tْf\xa0\xb5\xa3\a\xbdIK\xd8e/\x9cq\xdeŀ\xa6\x85\x9c\x90\x9f\xfc\x06\x83V[+\xfe&\xae\b\fI\x81\xf6\xeb\x13\x89tӨ\xd6\xd48y'<`\xd2$\xd7\xd3\xca\"\x90\xc17d\xf4K\xbc\x0e\xb5y\x02\x90\x8e\x05\xec\xa5{I\xf5x^\xf2\x1ḅ\xb8xLY\xb8W2],jF O\x7f\x03\x12\x81\xe7\x8c-\x17\x0f\xbb\xf1\xef\xd9c˽\xd0+\xa7=\xef;\xd4\xc5V\x03ؽ\xbf\x13&<\xaf:\xa5\xb3\xcaFs6'D\x9d\x9f\x84\x84D~B\x0f+\f\xa4\x82r(\xa3&x\x9e\x12]w;0\xee\xd0H\xd8Q\xbe~G;\xde\xfeh\x0f\xcaJ\np\xc9\xd9SPY\xaa\x89zʘ9l\r\x88\x95\x88\x95\x11\x02\xf0\xd9cENw\xf6\xab\x9e]'\x06\xa9\xda\t%\xb8\xef\xa7\vQ=\xbeX\f\\\xa9\xca\x05\xee\xa9\xc3NQ\xe7G\xe3\xd0\xcc\xd9\x19\xd7B\x03\xc6}\xbf\xfeX\f \x8cg\xfe\xb0\x19S\xabGcɂ\t\xde\x00\xe6$}\xf6\x1c\x98\xf5\x9f\x8a\xbcFy56\xe0\xddS{g/\xee~\xfcC\xd47\x1c\x06=\f\xfe\x83\xa5\xb3\xf1ݯO\x17\"C5\x8c~{\x1b\xd7su\xc9/\v?\xf2\xfc\x8a\x9b\"G\x00v%\xfa\xf0\xbb\xb7v\xa0\xb9\xa5\vK\xe6O\xc4¹U\xae\xd0c\x91\xc8!6\xfc\xe0\x18-\xff\xe5\xfa8c\xe4\xa5\x1e\x05\xc5'*\xd3E\"O/?\xf6\xd2\x06q\x936\xa3\xb6\f\xff\xf07g`RŨL{@x\xbc\xac\xe4'\xf8\xd1\x0f\x85\x06\xb8\xd7\xe0\xb4ό\xbcf\x04\xfa\t\xbf\xa0\xa3\xd5\x0f\xa6\x14\xc5\xc5j\a\xb4?\xad\xd8\x11\xfa\x8a\xe2\xf6)\x13+\x8b\xb8z\x00\x13*\x8b\xc4\xf9P\x9c\xcf\x01\x10\xd7S\x98\x14/\x10\xabB\xf07\xe3}\x9a\xe4\x97\xceOBB\"\x9aP\a_?3d:\x9e;3_'\xb7\xe6\xc3=X\xf6\xc0\x9f\xc4\xc0BB\xea\x86{ֈ\x87\x16\xa8\xd3S˽\x10\x01\xa5\xe2\xd4읣S\xbemw;\x1eX\xbdV\x88C\xbe˿\xf3\xd1\x0f`Y\xfd\x0f\x8f)q\xc7\xc6qHQ\xe7GI\x9byr\xc655~с\xadM\xed\\\xc5ū\x7f\xda\xe9\xc5\xe8\xf15k\x06\xb4\x19\xde\xf7D\xf6܆c%38\x92W灧>\x11\xe2\x90\nR6\xb0\xfaw\x9f\xe3\xeduͮ\xa7[\x9b>\x93-H~\x03\xf0\xa3\x10\r\x9e\xa2O\xf4\x98\xb8\xfe\x9e5x\xf2\xb7\x9b\xf0\xea;MX\xf1\xd0{x\xe1\xb5\x06W\xe8\xb1hpŅ\xe3\x05UF{\x1e1u\x90\xf8d\xfa\xac϶\xb7\xe0\xa1\xe7\xd6\vqH\x85\xd4^\xef\xfa\xd5\a0\x15\xd5Y\xaa\xac(#,`\xa8\xe20\xea\xfc(\xd5\x15\xc53\xdagF>\xad\x8d\x05\xe2e5\x03\xb5\x85q(\xe9\xf6\xf8\\\xd3DeI\f\vNΌe\xbf\xf4\xec)\x9c\xf5\xeak\x06\xa6\x16\x05g8\xfc\x1eX\x05\x81Cy\xcfO&\x99d\x8afR\xd3\xf63\xa0\x8c\x9e\xce\xd9ph\x06L+\x85G_\xac\x17B\x80Sۑ^\xe1q\xa3<\n\xca\x00\xa3\xd4\x11\x9c'et\x86\x81\xceR3\xf0\xc7\xff\xde\x19\xb0\xc5\"\x91\x05\a=\xac\x92\xd3\x14u\x0e)\xea\xfc(i\xd5S2\xae\xe9Pk\"P\x87\xd1|\xb8\x9b\xb3\x81\xfajm\x9d[_\x9b:\x01\xa9\x90:̑\xc2:ޯo\xe6\x1an\"\xde,bԉՒ\xdf \xfc\x14\xc5\x14b\x8c\xbc\xe6tc\xf4\xdb7\x1b\xb1\xef`p*\xf8\xc9\xff\xd8$\xda\"{\xe0\xfc\x1eOΓ\xc7\x17N\x99R\xe2\v\x15ɒH|\xa6\x83\x1e>ڶ\xab]\bj\xbb\xa8\x98\x8b3\x85\xa1\x99\b\xec\xe63?\x16^\x13\v\x8cж\x94\xe85\xf1\xab\x177\xe0\xea\xbf\x7f\x1b\xb7\xae|\x17\rMm|\x9a\xdb\xd68\xd5\x14\x16\x88\xe5\xcb\xc8\xde$\xad/\x10\x1fK7\x02\xf4{\xfbu\xa7cV\x9d\xb7\n\xc2\x15\xe7O\xc37\x16O\xec\xaf\xebo\xc3V\x12\xe3G\x05\xed\x0f\x15Q\xe7'\x05\xb0\x14\xc0R\x00\xe7\xb1\x00\xb6;\xb6\x0f\x18\x9bG\x9d\r\xc5g\xd1 \x95\x8eu[\x9c\x87\x16\x92\x9d@\xd2yz\xb7sW\xa8(t;0\xc7\x1e\xdb\xf0cρ\xa3N\xb0^\x020\xbb3\x8e\x0f\x05Q\xe7G)\xb5\x7fO\xc6\x001!˔b\xd5\xd8p\xe1\x9d\xda\xe3\xf0\"\nM\x87=/\x9c\x9f\xa7\xf3\x19\xd9\xf8\xd1\xfa\x9dn\x1c\xf4\x81\xfdÊ\xfb\xcd\a~\xb6\xad\xc3\xee\xecD\x8a\xee\x96t\x1d\xf5[\x0f\xa7\xd5 \x11\bl\xde\xd1ʻ\xa1PzzȘȓ\xbdt\x8f*{F\x19\x14;\x1d\x86\xdd\xcdGE\x98\x90\xb0\x97M\x14\xa6\v\xc6<\xe6\xc7S\xef\a\x92Ɍ\xf6IٕO\x7f\x8aW\xde\xd8.nj\xe8\x01\xaf\x1b\xef\x7f\a\xfbZ\xfb\xbc\xba\xdc\xf6\xa8Ot^\xf2@\xf6\xf6Z1\xb7\x9d\xf9\xebR\xc8\xd9\x13w\x9c\x85\xe7\x7fy\x11~\xff\xf0%\xb8\xe9*gf\x84?\xd7g\xb35\xe9̂\f3E\x9d\x9f\x14\xc0R\x00K\x01\x9c\xcf\x02\xb8m\x937\xa08S\x8e\x81\x0e\x87\xfa\x1c+|,O\xf6\xf5\x0f\xaav\xbb\xe3)%{\xad\x9fet\x94nG\xe8\x94g\x13)\xe3*\x9c'\xbb[7pѰ\x11u~\x94\xcc\xcf7z\x9f\xef\\K]\xd5(\x9c4\xa5\x8c\xab\xb8\xf8\xfa\x92ɜuA߉\xd5\xf09\xef\xc2ڼ!0}\xe9\xb7Kuǖ\x8d\xe2\xaa\x01\x8c\xf7\xf1\xf6ۓ\xfcB\xf89\xa2+\xd5\xde\xee\xbe1\x8c\xa7\xec\xd3a:\xa13,\xf8\xb8\rs\xde>\xe2ܜ9\x02\x91\xbc\xad\xfez\xdc\xf69\xac\xa0j\x8c\xc7\xc3\x0fn\xb7\xf6\x91\x0e.\xf2D\xa1_$\xe69?\xc1\xcf\a\x8em\xe5롴\xf7\xe0Q\xac\xf9\xc8Y\xc2\xcbI\xf4\xf7}\xf9\xf5m\xee\xbe\xdb\xf6|6\xfc\xf6\x02u(nX\x8d\x89\xdfIc\f\x94\x15\xf6?`\xe6\xb7\xe1GCg\xf8M\\\xae\x0f\xbe\xf9\x11u~R\x00K\x01,\x05p\x9e\n`\xf2l\xda\xdd\xfb]\x0f\x8a\xebIq\xc4\x1duB\xf4\x80B\x81\x91\xe9E\x9d=\xad?\x1e/\xd5R\xcfE\xb0{\x0e\xc3N\x1c\xe4\xddP\xb1x\xce\xe9\x99\xd3\xc7E\xf1\x18\x16\xcd,\x13q\x88v\xcbF.\x1e>\xa2\xce\xcf/\xc6|\"\x8e\xfenw\xde\xf0UL\x1c\xe7yJ\xaf\xbcp:\xce]0Λ~\xf4\xc1\xda\xd6\xc0YX\x8d[3\x97<r\x06\"\x12V\xe4}\xe5\xefƏ\x8b\x16\xd5r\x16V\xa3o \x94\xfc\x82\xfc\xfc\x0f\\\xf5\xf5\t\x8f$}\xdf\xf40Z\x18\xa6\u05ccN/r\x13\xfd=\xec\x0eo\xea\xd9Nt\x03\xa9\x94+\x12\xb9\x1c\xcek\x86is\xd1b\x8f\ac\xcc\xe8Q\x98[C\xa19:\xec\x8e\xf6\f!{L\"1\xea\xfcҰ\xb53m6G3\xb0u\x97\xef3|i\xe7^O\xccsۣ\xff\xc7/\xba{\xb2\xdbK\x8b\xab\xe5\a\xcd\xd2E)\x83\xca\xd8\xe3\x9a\rJhi~\xf2\x93\x02X\n`)\x80\xf3U\x00S'\xda\xf8\ng=8\x1d\x10uJ$~\xaf\xbb\xe2\x94\xf4\x1anYj\xd7k\\$R\xaa\xe9\x0f\x9c\xcd\x00u\x86\vfW\xe2ڿ\xf4\xa6\xb7\xc8A\xf4\xc0\xb2\xc5P\fMx\x8bR\xbb^\xe5C\xc7\x05Q\xe7\xd7\xf7\xf6\x1b\xfda\x1a>N4\x90\xd4V\x16\xe0\xb9_\\\x84\xa7\xff\xf1\x021ez\xe3\xb7f\xb9\xcb\x13\xf1\xa0C\xfb\xe6\xbb\xef\xc0\xee\xf6<*}\xef\xbc\x05\xc3\xecr\x85\xa6\x7f\x90\xe2\xe5\xc0V\xfc\xf5W\x02\xe2\xf3\xfc\x855X\xfa\xb5j\xb1\xba\x82\xf9\xf6\x9a\x80=\xc9\xcf\xc7\xcf/\x0e\x9d\xfd\xd4\xc1\x03\"L\xe0\x9aKg\x8a\x1b%>D\xe9\xf2s\xea0\xb1,\x16\x1a\xc6\xc3o\x1c\xb4\x9a\x83\xf1\xca\xd6\xee\xdd\xdeqGD\xf21J\vO\xa9\xc05\x97z\x0f\x05\xd2g\xde\x7f\xf3\"\xf1\xff'\xec\xed\xf1\xbd\xae\xdb\x7f\xad\xb9\x88Ĩ\xf3\v\xc1{-\xed\xa2\xdd\xf3>\xb5\xa1\x92\xc2p\xcfe\xe1(\xc7S\xed\xb4M\xaa\xfbak\x87x5rV{N\xbbw\x93\x95\f\xfc\x1f\xf8\xdb;\xb5g:\x9f\xe3m\x8f\a\xa2\xceOBB\"\x9a\xd03JB`\xad_)\xd6\u07b5\x8b\xaa\xa1:a\x02n\xa7\xe3tb\x7fu~-J\x8b\r\xbc\xfe\xbf\xbbP\\\x18\xc3w.\x99)\xa6\xa1S\x8d/\xc3>\xf8\x11\x9b\x12\xc9\xfa\xf4\x17P'_\n\xa5p\x82絣\x01\xc2\xe9\x04\xa9c\xbb\xf6\xd2ib\xa91\x8a\xb7<mJ\x01R\xb1\xe2\xfe\a\xaf\xb6=\x0f\xfb\xf0:6u\\\x10u~\xa9\x96C\xe8~\xf8_ū\x88]N\xce`A\xc7\x05\x0fʳP\xf0\xd5!a\x99x\xf0\ued18۽\xe8~\xf4\x11\xe8\xcbn\xf7\xd6\xffԌ@\x1d\x12\x9f\xcf\xdc\x7f!\xb6\xeehCyi\x81\x98\xb6\xa4\xf7\xf5ǎv\xa2kս\\M\xf2\xf3\xf3\v\x11\x87\xe4Y57nD\xaa\xaa\x1aeq\r\xab\xef9_<\\\xba\xffp\x17\xce\xfdj5\x96.\xa9\f,#\xe6\n\x02G\xf8YMM\xb0ۃS\xfaf\xc3\x16\xb1\xf6.\xafU\xcc瑈\x16wĶ\x8a\xeb.?I<a\xdfv\xa4\a\xb3\xc6Š\xc4\v\xc4w`m\xdf\x0e\xfb\xe8ьkt\xe1ϧ#\xbdn\xd4\xf8e\x01ţ\xbe\xfcE3\xae\xaa\xa9r\xdb\xd2\xc9S˅\xf0\xeeJ\x04cU\x17\x9d\xe6,\xd1\xe7\xb4ӣ\xb6\x86'w\xee\r\xb5w\xf5\xa41n\xff\xe4\xf7\x8a\xba\b9\xd6m\xa5\xf0Dc04a\xb8\x88:?\xe9\x01\x96\x1e`\xe9\x01\xcec\x0f0l\v\xd6\xfb˽\xf0\x00\x86\xd3\xe9pl\xd6\xc5gVaխg\xe2\xde\x1f/\xc0\x8c\xc9e\xb0{[`~r\x1f\xd7\xf6`\xf5\xc2\xfa \xf8\na\x8e\xb9\xe4).\xb2GK\xfb\x9c6\xb5\ff\xac\xff\xa9l%\xd1\fs\xed=|\xca\xf1C\xd4\xf9\x01\xe8}\xe9Y\x98\x9b\xea\xbdA\x83\xafɹ\x1e\x16\x15\xee\x80\xe2\xe4I8\xa7\xf6\a\a(J\xbd/<\r\xb5a\x8b+0\xd8&\x87 0N\xad+A\xa5\x13[Ib9\xf1\xf8\xaaP{yί\x9f\x9f\x1e\x0f\x17^\xa9\x14\xf4ukExLU\x99\x81\x15\xd7\xce\xc1\xc3?[\x88\xa5K\xaaō\xa1_\x1c\xba\xd7f\x9a\xb0\x13\t\x98[\xbc\xf8t\x17\x96\x85\xbe\xfaz\xe1u\xe5\xef)\x10\xfe㤪r\x1d'O.\xee_\x15A3`w\x1e\x81\xf9\xf9&\xef\xfaҽ\xa2f\"p~\xde\xf1\x1b \xbd\xb2\xe7\x00vu\xf7\xb8\xed\xa8Ȱ\xf1wߛ\x0f'\xfcY$Z\xc6\xec\xf2\xc5c\xdd\x1b7\xba\xa6gw\xedC[\xc8\x03]doo2\x95ў\xf9\xbcм\x95\xc43M{ѝ\xf6=\x1c\x8f\x14u~R\x00K\x01,\x05p\xbe\n`\x1a\xa3\xf6\xbf\vs\xed\x9dbP\r\fDΔ\xb0_(үҹ\x13\xe6\x9a\xef\x03}\xe1O\\\xa7\xf6\xac\x81\xf9\x81\xf3\xfaڐΌ\x17\xbb'\xaf\x14\t\va\xef\xcdk\x8e\xeb\xea\b\xf9ďRײ\x1f\xbb/{\b\\\x97\x7f\xeb\x1blz~\xb3\x1a\xbd\xff\xfeo\\;\x03]\xcb\x7f\x82\xd4\xfa\xb5\xc23\xef\x0ej\x8e-\xdaם7=\xb1\x175\xb9\xfa1\xf4\xbe\xfc\x1c\x9f.\xf9\xf9\xf9\xa5\x8b,=\x1e\xd8O\x1d<\x00l\xda D\xb0hG\xb6\n\x18\x86+\xeaҷvW\x17\xfa\xde\x7fO\x88g\xfe\b\xd76\xd9ۿ\x0fؼIL\xf9\xd32\x7f\xee\x9bМ\aŨm\xf2\x8d\x1a\xb5U\xbb\xa3\x03}\x1f\x7f̧{\xd7\xe7\xcf\xfb\xcb\xd2a&\x82[=\x1e\xd8\x1f\xf1\xfcrH\xff\xb4y\a\x1a\x13\xa6\xdb\x0e\xcf;}\x1cV\xdf{\x01n\xb8\xf2\x14\xdc\xfd\x933\xb0\xea\xe6y\xee\xcd0\xd5ya\xf7~\xbcy\xa0\x85O\xcf\xc0ݛ\x1a={i\xfdKz\x9e\xfa\xb3\x17\xf6\xb6`\xcd\xc1\x81WԐ\xfc\xb2\xf3\x93\x90\xc8\r\xff\xc7\xde\xf9\xbdHrUq\xfcܙ\xea\xac\xd5K\xa2q2\x9aeAX\x11\x93Eg\x89b \xf1E\x14\x7f<\xe7EbX\x10D!\x0f\xf1U\xf1m\xff\x01A0AQ\xc4\ac\x10\x04\xf3\x10\xc1\xc75\xe6A\x83\xa28\x99\xec\xcafY⋳\xba\xec\xccf\xc6\xedڝ\xae\x99\n\xd53\xa7\xe6\xdc[\xe7v\xd5TW3Uw\xbe\xdfJS\xf7\xdc\x1f\xdf{\xcf\xf6L\xcf'\xb7\xaa\xbb\x01\xc0\xc1\x01p\xfe\xdf\u07b5_Q\xfa\x87/R\xb6y\xa5\xf8C\xc4/D\f\x89\x93\xf3;\xbf\xa6\xf1\xef\xbfJ\xd9\xc6\xdb\xd6xW\xb9\xdf\xf8\xb5/Q\xb6u\x9d\xab\nɯ\f\u07bd\xfas\x1a\xbf\xf6e\xca\xee\xfc\xcb\xe9ծ\x82\xcf\xef\xf6-\xda\xfe\xd6\xd7\xe9\xde/\x7fV\xfc\x91*r\x14\xc7\xde\xcd\xff\xd0\xf6\xf3ߤ\xe4\xa5\x1f\x16\xb7mhG\xbeӹ\xfd\xedoP\xfa\x8b\x97\xf6\xefQ<\xf0\x94\xbb\xdd\xf9\x83\xfe\xbbN\xdb߹H\xc9O~\xc4C\xe7\xa2^\xe7\xe7\x83,\xb1\x03\xb9\xfb\xee\xbfi\xef\xf5\xcb\x13X\xcba\xce\xf7\xf5\xc1\xf9m\n;o\xfcɾ\x94\xafx\xef\u07b8A;\x97/\xd3\xe2\xe8\xff\x93\xcf\xf2\xb6\xfe\xc7\xef\xe0\rc\xf9\xef\xc1\xc4\xef\xf5?\x12ݽc\x83f\xd5Y\x9b;\xd4\xfcj(\xbf\xb4\xff\xbd\xd5k\xf4\xbb\x9b\x9b\xc5:Ν}\x88\x9e\xfb\xda9\xfa\xc2\xe7\xce\xee\xef\xe6\x13ѭ\xfbc\xfa\xfe\x95w'\xbb\xa0u\xfd\xf8+\x83\xf9\xf5\xaa\xf8\xb9\xdfݡ\xf5{\xf7\xe9\ak\xefX~bc\xb6\xb5#\xf4\xfc \b\nK\xe6\x93_y\x93\u07ba\xf8Lf\xd5\u0590\xf9\xe8Ӵ\xf0\x91'\xc9,\x7f\x96\xcc\a\x96){\xef\x1a\xed\xdd\xfa;e\xff\xfb\xeb\xd1A\xce,\xd2£\x9f'\xf3\xc8g\x0e\xfd6\xd6hoc\xad\x99\x1f\x11\xad\xbc\xfcj\xf1\x1a\x18z~G\xf9\xcc\\\xd6\u0099\xb3\x14=\xf94E\x8f\x9d\xa7ŕ'({o\x8bv\xaf\xfc\x93\xd2|\x97\xf1oo\x1e\xf9M\\\x85\xdf\xca\x13\x93oR\x93~\xe3?\xbf1y\xb7?\xf7\xad\xab\xa7\x96^1'!\xbf\xd5\x17ֳ\xe2\x96\x00\xf7\xccr\xe2\x85G>L\xe6\xe1%Zx\xf8CD\xa7\xe2\xc9Gwe\xb77h\xef\xcef\x19\f]\xb9\u07b9\xdf\xf22\x99\x87>H\vKKD\xa7NU\xfb\xb1\x87{>Ѕ\x17\xcf\x14?\x9f\xa1\xe7\xd7\xe4#\xb5\xf2\xaf\xea}\xec\xc1\xd3\xf4\xf8\x83\xa7\xe9\xdc阶Ҕ\xaenݝ\xdc&\xf1\x8f\xcd-\xebMa\xf3\xf0+\x16?\xe5\xf8\xed\xe3\x1f\xa3\xf3\xcf^7!緸\xb4T\xa7+\x00\x18\x00\f\x00\x06\x00\x1f\x02p\xd75+\x00\xf7)\xbf&\x80\xd8\a\xcd\x02\xc0}\xcao\x02\x88\\\xe9\x80Ve\\\xf7\x98\x97\x8fG%\x00\xf6\x8d\xaf\x8a\xebj^>5\xf2k\x02\x88\xc7)\xe3\xc4\xf3\x00\xe0\xbe\xe4\a\x00\x06\x00\x03\x80O.\x00/4\x18\x03AP\xdb\xe2\xcb\xea.|\xf1\xee#\x91\xde^W\x9a\x8f\x8c\xab\x94&\xfa\xfci\xa2\x97\x89\xf4~Q\\\xbd\xae&\xd2|d\\\xa54\xd1\xe7O\x13\xbd\xdc3\x19\xf1\bQ\xa1\xe7\a\x00\x06\x00\x03\x80\x01\xc0\x00`\x00p\x98\x00\x1c\xc5e\xd0\xe2\xf3`h\xc7ny\x9a\xa6A\xa1\x1b\xfb\xca\xdcWk\x93\x1e\xb2\x0fQ\xf5\xf8\x90\xf2\xeb\xb0B\x87B@/\xa0\x17\xd0\v\xe8\xad\v\xbd\x00`\x000\x00\xb8k\x00\x9c&\xe5K\xf0|\x1e\x8f\xf6?\xc3X\x831\x8e}e9\xa6*\xf6\x95]\xef(\xf6ǲ^J\xb6G\xb1\x9dC\b\xf9\xcda'\xb3\xadG\x97\xa4\xado\xd6\a\x01\x80\x01\xc0\x00`\x000\x00\x18\x00\f\x00\xee)\x00G\xb1}\x96\xa0\x95\xd7\xe5o\x8aJ\x93\xc3\xddR\";\xf6\x955O\x1f\xe01\x84j㤷\x1c\xe3\xf6\xe5\xb3+\xb7=\xb4\xfcZP\xe80\aX\x05\xac\x02V\x01\xabMa\x15\x00\f\x00\x06\x00\x87\n\xc0ib\x9f%\x801\xbc\r\x86\x87\xa0\xa8\x81c\x96\x95\xeb\xe5x\x1f\xf0\x19\xa3\x8f!*\xfb\r\x86v=Qy\x1eM\xb2\x0f\x8f\r)\xbf\x195\x1f\xd7\xee(\xf4\xfc\x00\xc0\x00`\x000\x00\x18\x00\f\x00\x06\x007\x01\xe0(\xb6\xcf\x12\xc6\x18\xd0\xe4\xad\x02\xd3\xc01M\xec~\x12\xf0\x88\xec\xfe\\\xd6\xe6\xe4:\"\xdd\xc77\x8f\xa6(.\xfb\x86\x94\x1f\xe0\xd0\v\x87\x80_\xc0/\xe0\x17\xf0\xdb5\xf8\x05\x00\x03\x80\x01\xc0]\x01`\tb\x1a\xb8\x19S\x064\x1f8V\x81\x1c\xf7\x1f\f\xed~26ƿS;m\x1e\x9fBϯ\xa1\x8c\x15\x85\xa7\xd0\xf3\x03\x00\x03\x80\x01\xc0\x00`\x000\x00\x18\x00<\v\x00G\xb1]\x96p8\x18\x96c\";β\xfa \xe7\x03K\x19\xbb\xfd\x89\xca\xf5\xda<>\xc96\xf6q\xd7/c\";\xae\x9aW\xb6\x1fG~\x80\xc3\x12\x1c\x02~\x01\xbf\x80_\xc0oW\xe1\x17\x00\f\x00\x06\x00w\x05\x80Y.|\xb9\xf0\xe7B\x9al\x1f\f\xf5>.\xc8\xc9X\xf6\x93\xb1\xdb\xdf\x1d盧J\xa1\xe7\a8\x9c\xc0!\xe0\x17\xf0\v\xf8\x05\xfcv\x19~\x01\xc0\x00`\x00p\xd7\x008\x8a\xa7\xefb\xa6\x89\xbe\xab9\xed\xf2\xbe\xdb\x16\xc5\xe5\x98Hog\x1f\xa2r\xbd;>\x8a\x95\x84\x9c#\xf4\xfcj\xc8XQx\n=?\x000\x00\x18\x00\f\x00\x06\x00\x03\x80\x01\xc0m\x03p\x9aTÚ\x16\x8fGz\x7f\t\x87\\&\xb2cm.\x8e\xb9/Q\xb9ޝ+M\x94\x84\x9cC\xf3\x92\x1e}\xcf\xef\x84\xc3!\xe0\x17\xf0\v\xf8\x05\xfc\xf6\x01~\x01\xc0\x00`\x00p\xd7\x00\xd8\xdd\xd5$\xb2/\xffO\x8b\xb5\xfeYf\xb73\xe4\r\x86\xe56\"\xbd\xef4 t\xdbOz~'\x18\x0e\x01\xbf\x80_\xc0/\xe0\xb7/\xf0\v\x00\x06\x00\x03\x80\xbb\x06\xc0>@\x93`\xe6ƃa\xbdvmGu0\xd4\xfb\xba\xeb\xf0\x01\xa1\xdb~\xd2\xf3;\xa1p\b\xf8\x05\xfc\x02~\x01\xbf}\x82_\x000\x00\x18\x00\xdc5\x00f\xe8r\x01L\x82\x99\x1b\x8fGv\xac\xb5\xcbz\r\x14e_\x17\x1c\aC{L\x9a\xe8\xf3D\xb1\x92\x90s\xb0\x87,\xbb>i\xa2\xafiZ\xbb\xac\x97\xde\xe3\x91\xee5\xaf\xfc\x14\x19+\nO\xa1\xe7\a\x00\x06\x00\x03\x80\x01\xc0\x00`\x000\x00x\xde\x00\xec\u0097\x06fMb\xad~\xdaX\r\x14\xe5z\xd2\xc4n\x93k\x9f\xa6\xd0\xf3sd\xac(<\x85\x9e\x1f\x00\x18\x00\f\x00\x06\x00\xf7\x1a\x80W^~5\xb8\xd7\xe9\xb7.>\x93Y\x15P\x18\xaa\x829\r\xd4\xea\xc4Z\xbd\x04\xd1(.\xc7\x12\x14\xdd\xf5\xf8vP\x15]x\xf1\xcc\xc1Ģ\xcf`x\xb8s\xcb\xeb\x922\xa6~\x9c&\xe5zc\xec\xfa\xc1\xb0\x1c\x8fG\x87\xbb\xd1r=\x9c\xcbxd\xaf\x99\xcfD\xb4\xfa\xc2z\xe5\xef\x9f)\xd5\xf4OW\x7f\xf3\x89\xcc\x1c\xe4\xa2=\xfa\xa0\xcc\xf38\xff\xec\xf5\x10\x9e\"\x000\x00\x18\x00\f\x00\xf6\x030\x04\xf5F\x12\xd2$\\r\xbd\v\xa2uc\xad\x9eH\xafϲ2\b\xa7I\xf5\xad\x04.t\xba\x92}\xf21\xf9XclД\xedZLT\x8e\xb5\xfa,\xd3\xeb\xeb\xc6\f\xc52\x1fY\xae\x10Ȫ;d\x85\xe7\xa2;\xcf\x05\x00\x18\x00\f\x00\x06\x00\x03\x80\x01\xc0:\x00\xcb\xdd\xd9(.\xef\xb2\x125\x8b5\x7f\xadޘ\xf2\xdc.\b\x13\xf9w\x88\x19\"5\xc9>\f\xbe>\x10\xd5\xc0\x97\xeb8\x969\xf8\xea5\x90\xad\x1b˹]\x7f\x00W\xaf\x80\v\xcfI\xf7\x9e\x13\b:nE\\\xa8s\v\xc1,\x97\xdd\xdb\xf0h\xd3g^\xbem\xf8\xb4\xb5\x16\xd6SK\xafL^\xff\xffr\xfb\xb9\xec\xb8}أ\x8d\xf5\xb4\xed\xc9>\xb3\xaek&\x1f\x86W\xbe4\x9f&t\xe1\xa7\x1f7\xd6ew\x06I\x86\xb2\x1a1߆0\xb9t\xcf \xc7g\xdf8w\xae\xc1\xb0z-u\xc4}\xa3\xb8t\x9b\xc4\xeawof4\x1e\x95\xdaKc\xdc8\x8am\x1f^_\xd587\xce=~\xfc\xe8\xbe\xc7\xf37\x0e\xff\xad\xf8\x16\t\xd1O;\x8c\x13\xe7\xc7ڥ\x9dI\xf5\xa7.=\x90i\xedu\x8f6|أ\x8d\xf5\xf0\xed\x05o\v\xcfO_z \x9bէ\xa9G\x95\x8f9h\xc7\x0e0v\x80\xb1\x03\x8c\x1d\xe0Z;\xc0\fcn\xf9(jãM\x9fy\xf9\xb6\xe1ӆ\x87\x06cn\xf98|\xdcqM}\xa6y4\xf5\x94\xe3\x9az\xb4\xe2#\x81\x92\xa8\x00N\tw\xc5n%ØܥU\xe2b\x9c\xe6\xc1><FƎ\x17\x83a\xe5Z|\x87\xa7o\xe1\xc5\xf0)w\x80\xb9_\x8d]`ˇˮ\x97\x16\xfb<\xf8\xdf\xde\xdd\r\xf6\xc88\xb1\v\x9c9\x98e-\x80kS\x1f\xe91\xebz\\\xd8\xf4\xcd\xd1ħ\x89G]\x9fR\x05\x00\x18\x00\f\x00\x06\x00k\x00\xac\x01\xd8Q\xa1\xac\r\x8f6}\xeax\xd4\xf6}\x9f};Hn\x15\a\xc28n\\~\v\xe7\x16\xc9\xfd\x8f3\xeb\xe4\x18\u07b8\xcaSI\x19\nd\tu\xb7\x1a!\xe4\xff\xc7b&D\xfahy\xf5+\x9e\x93٣\xed\xf1\xe8Hal\xed^\x8d\x9e\xd4zm\x8fd\xaf\xb63\xb6^\xdb\xe1\xd63\x83\xd9\x1ccc\xfe\xee\x05h[\xbc\x85\r~.\xea\x98\xddW\xf5\xa4\xae\xc8\xdad\xef\x1c\x9c\xf3}\x8f\x87\xae'\xb6G\xdb\x11\xae\x15&\x060\v:=zb\x1d[E\xf3\xac\x10\xad\x96\x0e\xcf\x1e\x00\f\x80\x01\xf0\x9b\x03\xf8\x17\xc0k\xf0*A\x99\xa5cm\xad\xa6G\xb3WӫYk\xe9\xb0\xf4\xaf\xc1K\x832\xaf\x1e\xeb3<\xf7h{4\xcf\xf0\xea\x19߾\xc606f\xfa\xdd\xfcM\xed\xe9\xb4D\xdd0,\xde\x1e\x9b:\x9e\xff\x9d\xd6\xe6z\xe6\xfbb\xb9\xdf\x16\xcf\xc8\xf6\xdeo\xd1}\xd3=I\xcf\xefg0\fe\x1d\xe3,\x97kb\xc5餁\x97\x06\xaf\x1e=^\xb3H\xc0)y\xa6g\x87g\x0f\x00\x06\xc0\x00\xf8\xcd\x01\xfc\v`\t\xb8<\xd6\xd4z\x8eu\x8fǚZ\xcfт\xcbk\x8d$^={<S\xd2\xe3\xb5fD\xd9\x1a\\_r\xb9.\x918\xde\x1b\xbfC\xbc\x92\t}\xa9\x0e\xe1,\v<\x8e]\xb1\\\xae\xf1=\x89\xfc}\x0e!<\xef\xb7E\x97\xa4g\xf1\xc67\xd2!\xb9\xa6Y\x04\x97\x04\\\x12xւ\x9b\x16\xc19pz&\xf7\x19Hg\xa9\xf5Y\x02`\x00\f\x80\x0f\f`\r\xb4<\xc0\xb6\xb6Nڱ\xd7Zi\x8ft\x9dG\x8f\x18Z\x99\xb5Ҟ\xdc:\xaf\x1e\xcb\xda\xdc:\x8f\x1ei\x87t\xad\x14\xbf\x13\xfc\xe6\x98\x13\xbe\xb5\x8d\xf6\x84\x1d\x8aY\xa2\xf3Į\xfbm\xb9V\x90\xc5\f\x01\x86\xa5=/o\x93/W\xd3<\x92\xcfC\x03\xad5xJ{rx\xf5\xeaт3\xf7l\x8f\x1eMGn\x1e\x00\f\x80\x01\xf0\x9b\x03X\v\xb5\xd4z\x8f\x1em\x87t\x8fW\xaf\xb6'\xb5ޫG\x8b\xb1\xb5=ڞ\xd4z\xaf\x1e\xed\x1a\xc9z\x8f\x1emGn\x8f\x14b\xc9\xef\xa7\xfe\xfb\x10C-\xf9\xdc'\x82\xb5\xb3\xbc\xcc\x13\xbb\x12߳\x15\x03v֭\xed\x99>\x93\x7f\x1f\xd9\xef\xfd\xae]k\xeb-\xc0\x8a\xc1Sۓ«W\x8f\x15\x9c\xa9\x19<z,\x1d\xa9y\x000\x00\x06\xc0o\x0e`-\xc4R\xfb<z\xac\x1d\xb9\xbd^\xbd֞p\x9fW\x8f\x15c\xb1\xbd֞p\x9fW\x8f\xf4w\x9aN\x8f\x1ekGj\xaf\x16b/\xfbV\xfeXM\xd5c\x00t\xaaG\xf3;1`\vz\xc2?\xae+\xea\x19\x7fxƂ\xb1\x18<\xad@\v\xf1Z\xda3\xefz\x14\x803\x9cţ\xa7\xa4c<\xe3\xf8\xff\x84\x90\xf7\u0379\x04b!ȼzJ\x13\xebٲ۲߫\xa7\x14c[t\x94v\xc5\xf6{u\x96\xf6l\xd1a\x85X\xb8\xbf\xb4\xc7+\xb19Z\x9b\xadt\x9e\xf9~\x0fP\x85\x80-阃\xd3\v\x88\xa5g\xfc\xef9[i\x8fG\x87\xc7y\b!\x84\x10B\b!\x84\x90C\xe5\xa2X+\xce\xe7\xe7\xb7\xfae\xc6Ϗ\xfd\x9f]k\xa7\xf7\xf3}\x7f?\x1e\xba\x1d\xa7\xd3\xd7\xd70Dns\xbe\x1d\xce\xc7\xc5\xc5\xc5\xc5\xc5ŵ~\r{\xa2\xf0HX\xec\xfd|\x16\x14\x1e\t\x8b\xbd\x9f\x8f\x10B\b!\xf2\f-\xc1\xb0E(\xf6~>O\x18\xb6\b\xc5\xde\xcfG\b!\x84\x10}\x86\x16a\xd8\x02\x14{?ߖ0l\x01\x8a\xbd\x9f\x8f\x10B\b!\xf6\f-\xc3p\x0f(\xf6~\xbe\x9a0\xdc\x03\x8a\xbd\x9f\x8f\x10B\b!\xe59G\xee5\x89\xc3\x1a\xcf\xef\xfd|{\xe2\xb0\xc6\xf3{?\x1f\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80+\x03xo\x1cn=G\xef\xe7k\x05g[\xcd\xd1\xfb\xf9\x000\x00\x06\xc0\x00\x18\x00\x03`\x00\\\x19\xc0\xad\xe0p\xabyz?_k(\xf3\x9e\xa7\xf7\xf3\x01`\x00\f\x80\x010\x00\x06\xc0\x00\xb82\x80[á\xf7\\\xbd\x9f\xafU\x8cy\xcd\xd5\xfb\xf9\x000\x00\x06\xc0\x00\x18\x00\x03`\x00\\\x19\xc0\xad\xe2\xd0k\xbe\xde\xcf\xd7:\xc2J\xe7\xeb\xfd|\x00\x18\x00\x03`\x00\f\x80\x010\x00\xae\f\xe0\xd6qX:g\xef\xe7;\n\xbe\xacs\xf6~>\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00W\x06\xf0QpX\x1b\x95GI\xef\xe8\x02\x95\xa0\x12T\x82JP\t*Ae{\xa8<<\x80\x01m[\xa0\x05\xec\x80\x1d\xb0\x03v\xc0\x0e\xd8\x01;`\a\xec~`?\xbf\x1b\xb6\xc0$\x98\x04\x93`\x12L\x82I0\t&\xc1\xa4\x1f&\xbb\x000\xb0m\x13\xb6\xc0\x1d\xb8\x03w\xe0\x0e܁;p\a\xee\xc0\xdd\a\xee瞐\x95\x9b\xbf\xf7\xf3\x1d\x1dY\xb9\xf9{?_\xb3\xf9\x9f};:m\x18\x86\xc20\x8aL\x16\xf1\x9d ke\xa2\xac\x95\t\xa4Q\\(\x88\xb6\xb84\xad\x8b\x8cs}~\xbd\x98R\x02z;|\x89\x01\x18\x80\x01\x18\x80\x01\x18\x803\x03\xd8\xcc\xcc\xcc\xcc,\xfb\xa6\xdf\xd6\xc5W?\xd9\uf5fd.\xaa\xa7\xea\xa9z\xaa\x9e\xaa\xa7\xea\xa9zz\x8cz\x9a\n\xc0\xa0\xfb\x1a\xd0\x05y\x90\ay\x90\ay\x90\ay\x90\a\xf9\xffA\xfe\xd2\x1f\xb6\xae\xde\x1f\xfdq\xb5\xb8]\xfb\xe3\xf0\xcf\x18u\xb2\xdfo\x8e\xd2\x1fWku\xd9\xed3\xdco\xdb\xfd\xcc\xcc\xcc\xec\xef\x9bTS\xd5T5UMUS\xd5T5UMUS\xd5\xf4Y5\xf5\x13\x88\x03\xff\x04\x02\xe8\x81\x1e\xe8\x81\x1e\xe8\x81\x1e\xe8\x81\x1e\xe8\x81\xfe'\xd0O\x89\xeffffff\xb6\x1a\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x030\x00\x03p\x02\x00\x97\xac/U\xb5\x16%\xf3Kc\x9f\xef\x97\U00065a88r\x9a\xfb)\xc0\n\xb0\x02\xac\x00+\xc0\n\xb0\x02\xac\x00+\xc0\n\xb0\x02\xac\x00+\xc0\n\xb0\x02\xac\x00+\xc0\n\xf0\xb0\x02\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\xbc3\x80/\xfda\xcb\xea\xfd\xd1\x1f\xbf,nס\xff\xb7\xd7\xc9~\xbf9ʷ\x7fou\x19\xfa\x7f{\x9d\xec\xf7S\x80\x15`\x05X\x01V\x80\x15`\x05X\x01V\x80\x15`\x05X\x01V\x80\x15`\x05X\x01V\x80\x15\xe0\xa7\x05\x18\x80\x01\x18\x80\x01\x18\x80\x01\x18\x80\x01\x18\x80\x01\xf8T\x00~\xffNw\x9e\xf3}\x87\xdb\xda\xc7\xf7\xd5\xd9\xefW\xeb\x92\xee~\x11\xe54\xf7s\x1c\xe7\x00獽;HY\x1d\b\xa20\xba\xff]?\x1c<\bbbU\xe5&\xfd\x13O\x0f\xa5sux\b\x1f\xa8\x01\xd6\x00k\x805\xc0\x9f\x1a\xe0U=l\xf5\xdeٳ\xeawW\xef\x9d=\xabz\xd8꽳g\xd5\xef\xaeޓ@H $\x10\x12\b\t\x84\x04B\x02!\x81\x90@H $\x10\x12\b\t\x84\x04B\x02!\x81\x90@H \x96$\x10\x1a`\r\xb0\x06X\x03\xac\x01\xd6\x00k\x805\xc0\x1a`\r\xb0\x06X\x03\xac\x01\xd6\x00k\x805\xc0\x1a`\r\xb0\x06X\x03\xac\x01~j\x03,\x81\x90@H $\x10\x12\b\t\x84\x04B\x02!\x81\x90@H $\x10\x12\b\t\x84\x04B\x02!\x81\x90@H $\x10\x12\x88\xa7&\x10\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\f\xc07\x01x\xfb\xa7\x11O9O\xfcs\x8f_\xfaӈ'\xfe\xb9\a\x00\x030\x00\x030\x00\x030\x00\xff!\x00{\x03\xec\r\xb07\xc0\xde\x00{\x03\xec\r\xb07\xc0\xde\x00{\x03\xec\r\xf0O\xbd\x01\x06`\x00\x06`\x00\x06`\x00\x06`\x00\x06`\x00n\x01\xf8\x95\x10\x9c\xcd\b\x12\x1bɝ\xabv\x13;\xa9\xdf\xf2\xff\xbc\x12\x82\xb3\x19Ab#\xb9s\xd5nb'\xf5[\x00\x18\x80\x01\x18\x80\x01\x18\x80\x01x\x11\x80\xb7\x10\x9b\xa2,\xb1\x91ܹj7\xb1\x93\xd8؞-Ħ(Kl$w\xae\xdaM\xec$6\x00\x18\x80\x01\x18\x80\x01\x18\x80\x01x!\x80?\x01\xac\x8b\xb2\xc4Fr\xa7\xb21\xd9M\xec$6\xf60v\xf4\xd9\xd5\x1bɝ\xca\xc6d7\xb1\x93\xd8\x00`\x00\x06`\x00\x06`\x00\x06\xe0\x85\x00>\x82\xd7\x19\x94M6\x8e\xeevv:\xcfvv;w'\x1b\x93\xfd#x%P\xd6\xd98\xba\xdb\xd9\xe9<\xdb\xd9\xedܝl$\xf6\x01\x18\x80\x01\x18\x80\x01\x18\x80\x01\xf8b\x00W\xc0\x95\xb8s\xd7\xf7L\x9fIܹ\xeb{\xba\xe0Jܹ\xeb{\xa6\xcf$\xee\xdc\xf5=\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\xbc\x10\xc0\x1dh%\xc0vt\xaf\xba\xb1\xeanu\xa7z/\xb1\x93\x82Vu\xe7\xe8^uc\xd5\xdd\xeaN\xf5\xde];\x00\f\xc0\x00\f\xc0\x00\f\xc0\x00\x1c\x04p\x17j{\xf7\x13;ݍ\xea3\xa9\xdd\xee\xce\xde\xfd\xd4\xce\x04X{\xf7\x13;ݍ\xea3\xa9\xdd\xee\xce\xde\xfd\xd4\x0e\x00\x030\x00\x030\x00\x030\x00/\x00p\x17b{\xcf%v\xa6\x1bߞM\xedNwޟK휁\xd5\xfbs\x89\x9d\xe9ƷgS\xbbӝ\xf7\xe7R;\x8e\xe38\x8es\xcb\xf9\xc7\xde\x19$7\x8dDa\xb8\x93\x9a*\xed4\x9a\x85֣9A<'\xc0\x9c \xe6\x04\x98\x13$7\xc09\x01\xce\tbN\x80\xb9\x81s\x02\xcc\t0km\x8cv\xdaQ]\xf5\xbaʸ\x14ǒ\xbb\xfb\xbd~\xfa\x7fU\n\xb7\x8c\xc1\x9f\x05\xd2秧\x16\x1e\xf8\x7f\xe0\xebּ\x92o\xf1\xab\x99\xd1\u05edy%\xdf\xe2w\f\x8c\b\x82 \b\x82 \b\x82 \b\x82 \b\xf2G\xfer\x0fb\xa4\xad\xf3\x1bcLa\x8c\x99Я\x87\xcb\xde\x18\xb35\xc6쳲\xf9\xeeVj\n\xf8\xc1\x7f\x82\x7f\xad\x95\x1bA\x10\x04A\xa4%h/b[\xe7\xb7Ƙ)\xfdL\x8e\x9e~-V\x867\xf6'+\x9b\xafneJ\x01?\xf8{\xf0O\xb3\xb2yv\x03\r\xe9\xe0\x9f\xa5\xba-!\xc0\x10`\b0\x04\x18\x02\f\x01>)\xc0m\x9d\xbf1\xc6\xcc\xed\xc1\xae\xa3\xca5t\xb1\xd5\xe1\xb51f%]\x12\xc0\x1f\x84?\x19q\xba\x80_\x85\x00\xbf¿\xc8\xca\xe6\xc1\r \xc0\x10`\b0\x04\x18\x02\f\x01N^\x80\xdb:\x7fo\x0fpƘʭ\v\x94\x1d\x1dH?\xbb\x15\x12\x02\xfe`\xfc\xb6%\xe6\x1f7\x90\x1a\x0f\xfcI\v\xf0\x99\xfc\xf6\v\xec\a7Д\x03\xfe\x8dVF\b0\x04\x18\x02\f\x01\x86\x00\x1f\b0U|V\x17\x1c\xf8\x87.V\x84\xe7\xdc\xd2\x00\xfe\xe0\xfc\xa2\xa5\xc9\x17\x7fV6\u07be\x8c\n\xe6\xb7r\xf8\xd6\r4\xa4\x83ߞ\xad\xaa\xb2\xb2\xf9Ec\xcc\x03\x8cy\x801\x0f0\xe6\x01\xc6<\xc0\x92\xe7\x01\ue6f6\xce\xffn\xeb\xfc\v\xf5iV\xe7\xbf\xd2\xdbb\xff\u038d}\x0f\xf6\xbd\xb8\x95\xb1\"\x84\x7f~\xb4N#\xff\xfa\xf4\xd3\xea\xf9\x8d\"\xfe\xe9\b\xf8\v\xce\xff\x97\xa8\x00\xa3\x02\x8c\n0*\xc0\xa8\x00\a\xac\x00S\xd5c\xdd\xd1\xe3Ǖ=\xf5\x89>G\xac\xfap\xf3\xb3U\x9a\"\xf2\x8bl\x7f\b\xc1\x9fR\x05\xf8B~\xfbo\xf6\xa7\x1b\xa4\x983\xf8wY\xd9\xfc\xe7\x06\xdaB\xfcjgkA\x05\x18\x15`T\x80Q\x01\xee\xac\x00\xb7u~GU\x8f!\a\xbfP)\xa8\x1a|w\xb4\xde{\x04\xf1/\x98\xe47&\xbf\xb8\xeao \xfe\xed\xe9\xa7U\xf1W\xfd~{\x92\xfc\x15I\xa2\xba\xe5\x80\x7f\xcdq\xe6\r\x02\f\x01\x86\x00C\x80Y\x04\xb8\xad\xf3'c\xccҍ\x05fI\xef1\xc8\"\x88\xdf\xf6R>\xbaA\xac0\xf0\x8b\x12\xe0\x80\xfc\xfb\xd3O\xab\xe2O\xb6\r\xa2'\xff\xbd{\xa0%G\xfc\x15\xf5>C\x80!\xc0\x10`\b\xb0n\x01\xa6\x9d_\n\xbdms\xba\";\xc4\xce_\n\x7f\xf4\x83+\x03\xff^\xd2\xd4g¶\x7f\xca\xfc\xc5e/O\x86\x7f\xd6\xd6\xf9\xbfn\xa0t\xfb\xcfB\xeck!\xc0\x10`\b0\x04X\x8c\x00\x0f\xd8\xf9s.+\xdfS\x84\t\xe3_\xc6\xee\xbdc\xe2\x17S\xfd\x8d\xc0/\xba\x05\xc23\xffd\xd8˒\xe4\xf7\xf5\x99I\xe6_\xd2\x1d\x0e!\xc0\x10`\b0\x04X\x97\x00S\xcfW*;r\xefSf\t\xe3\xdf\xd1\\\xa3\xd1\xc2ȿy\xf9)u\xfcb[ \x02\xf0'%\xc0\x17\xf2\xfb\xfcܤ\xf2\x17\x9a[!l\x85\x1b\x82\x0f\xc1\x87\xe0\xeb\x14\xfc\xeb3\xae\xf6=\xb7\xe7M\xa3\xfcJ㿏y\xe1\x1b3?{\x05X\xe0\xf6\xd7\xc0_\xa4r\xf1\x94\a\xfe*\xe5\x16\x81\x1e\xfc\x93\xb6\xce?\xb9\x81\x96\x10\xbf\x95\xfb-Z=\xd0\xea\x81V\x0f}\xad\x1e/\n0\x1d\xa4Ĝ\x86f\x90_i\xfc\x9b\x98=\xb1\xcc\xfck\x8e\x19.\x18\xf9wݫ\xd5\xf2\x8b\xaf\x02{\xe4O\xb2\n<\x80\xff\xbe\xad\xf3[7H=\x1d\xfc\xab\xb6\xce?\xba\x01\x04\x18\x02\f\x01V,\xc0\xf4ͷ\x18\xa3\xfc\n\xe5\x8f} \xe5\xe4_\xf7\x7fI\xd2\xfc\xe2\x0480\x7f\nS\xa1\xf9\xe2\x9f&z1\xdc\x10\xfe\x15\x89\xa3\x86t\xf1/\xa8\x1f\x1a\x02\f\x01\x86\x00k\x15`:\xf53s\xe3\xb1ɯ@\xfeE̛\a\b\xe0g\x15`\x81\xdb_\x1b\x7f52\xfe\xa8}\xfb\x8c\xfc\x85\x90/\xaf!\xf9\xed,C\xdf\x14\x89>\x04\x18\x02\f\x01>\x14`\xfa\xf6;J\xf9\x15\xc8o\xef*\xf5\xe0\x06\x91\xc2\xc9\xcf\xde\xfe\xc0\xc0/\xed\"\xb8\xd0\xfc\xd2\xe7\x02\xf6\xcd?KL\x98.\xe1\x9f҅s)\xe75\xfe\t\xf5\x05\xdf@\x80!\xc0\x10`E\x02L\xcd\xfe\xd5X\xe5W \x7f\xd4\xd6\a\x01\xfc\xdc\xd5\xdf\xe8\xfc\x92n)\x1b\x89?\xea\xe7+\x80\xbf\x18XQM\x95?٩\xd1z\xf0Wt\xf7QUw\xfc\xb3}ܶ\xd7\x19\x15nT\xb8\xc7P\xe1\xber\x0f\\\xda:\xffq\xe6\x0e@\x9d\xfc\n\xe4\xb7\xd5\xd0wn\x10#\x02\xf8\v\xce\n0\a\x7fV6W/<\xa5\x96_\x12s$\xfemV6\xff\xbb\x81\xd4x\xe4\xb7}\xed\x13\x01gsb\xf0\xcf}\xcf=/\x80\x7fO3\x80,Sۆ\xa8\x00\xa3\x02<\xa8\x02L\xdff}\xec\xfcR\x95_I\xfc{\x86\xea/7?k\xfb\x83\xb0\xed\xaf\x9a_b\x8500\xff\x84\xfe|\xb1\xf1\xcc_\x9d9\x85\x9a\x06\xfe\x95\x86i\xe0\x8e\xf8\v\xea]ߡ\"\x8c\x8a\xb0֊\xf05\xe7\xe9vI\xf2+\x90\x7f\xc1 \x83\xdc\xfc\xdc\x17Ќ\xf6\x86\x1f\f\xfc\xd5\xf0\x97&\xcb\x1f\xf3\xf3\x95\xf0\xfe\x82ܖ^(\xbf\x9d\x06\xee)qQ\xec\xe2\x87\bC\x84Պ\xf0\xb1\x00\xcfF,\xbf\x92\xf8\xed\xe9\xd2G7\x18\x9a\xdf\xec\x9dAN+G\x10\x86\x8d\x15\x89\x1d\x9a\r{\xe7\x04\x99\xdc\xc0\xb9\x81s\x02\x9c\x13\xe0\xdc\xc09A\x9c\x13dn\x10\xe7\x04\x19N\x90\xb9\x01Ú\x05#v\xacxj\xa9\x1a\x19?\x8c\xb0\xdd\xdd\xff_S\xd5%$\xf7{z\xf6|\xf4\x83\xf9\xa7\xfa\xef*\x85\xfch1Ȳ\xfe\x16\xf8k\x83\xfcKr\x01\x91\x83\x7f\xa3\xa8\fܹ\xfcK\xf1\x05k\x15\x89\x9f\xf1G!\xecMA\xbc)\xc8h\x9a\x82\xbc\t`)b~l\xdd\xc7ш_2\xfeU|Qj\x10\xf0w%K\xbd\x11\xf2C\x03\xc0_\x1b\xe5/\xfe\xb3\r\xe6WQ\x1a-!\xbf\xca\n\x11G\xf0\xcf\xc4\xf2q\xefB\u0605\xb0v!<%/MT*\xf3\xcb\xc4\x1f\x0e\x1d\xdc\xc5I\xc11'/=4V~\x16\vDi\xfe*\xdd[\xa9\xe2_\x9e\xff\x16\xea\xf85\xb4JNɯ\xb1Bı\xfc.\x84]\b\xab\x17\xc2\xcc\x02\xb8\xa4\xf8e\xe1\x1fd\x9b\t1\xe6\xc6\xfd\xbf\f\xebo\x89\x7fn\x94\x7f&ٶ\x891\xfe\x15\xb9 L\xcd_\x89\b\xd6\"\x0eO\xe5\x1f\x85\x10\x96\xe6&\xff\x8b\x8f\xfbFi\xf7F\x8f#\xe3\xad\x14\xd1\xcb\xe3\xd5k|mP\xfc\xb2\xf0\xc3\xca\xe9\x80\xf9\xe1%\xa2\x80\xfck@\xa3\x13\x16\xfe\x19\xd2\xf6\x02\xe4o/\xaf\x9f\x7f\x89\x13C\xfc\x83\xac9\xac\xd2\v\x88?\xec\xea\xfd\x1e'\x8c#!\x7f/\xbf\xd3T\x95\x85;\xc0\xdf\xcb\x0e]+\xf7(\x9az\xed\x1eibJX\x92\b!~\x19\xf8[\xa0\xf8\xfdɲ\xfd\x01\xcc\xdf\xc5\x17\x06\xf9)*A\x00\xf8\xe7L\x19\xa6\x82\xfc\x94~\xe0\x02\xfc!\xfb\xfd\x0f\xeb\xe1\xb8\xc4\xfc\xea2\u009f\xf0\xcfĲԈ\xaf\xfbI\xd6\xf16\xf1\xf7\xcc-\x10`\vDeU\xfc\x12\xf1#\xbd\x81\x95q\xfb\x03\x92\x9f\xa1\rr\xa5l\xdbu\f\xfcL\x87\xe1J\xf23\xb6J.\xc1\xbf\x10K\x04\xa3\b\xce\xc1\xbf+\x84\x19-?\xa7\xf0ǎ\x8e\x9b\x1dA\xfc\x9f\x94\x87\xd3\xe4\xf7v\x01\xbc'\x80k\xc3◁\x7f\r\xde\n\xae\xadV\x7f \xe0g\x88\x9a\xfc\xc63F\xfe\xa5\xe1\xf5gk\x95\\\x8a\xbf\x96z\xbal\xd9Ü\xfcA\boE(\xb2\x8a\xc4S\xf9+y\x88_\xcb\xc3\xcd\xeb\xae f\xcd\xf8\xbb\x00\xfe^\x00W\x86\xc5/\x9a\xbf'\xe8\x98TY\xb5?\x10\xf0\xf7\x86\xf9\xeb\xfc\x1fA\xcb_\x11m\x11#\xf8\xb7D\x02\xa1$\x7f<\x1cǔ\x15-\xc1?\x17nF!\x9c\x92\xffM\x10\aϻ\x1c\xac\xfb3\x1c|uA\xcc'\x88\xa7\xef\xa7&\xc5/:V\x8c\x87B\f\xd9\x1f\xa0A\x90\xfd֘y\x19\xcbX\x11_[\xee1#x\xf0G\x8dJ\x1e\x00n\x14]\xb3\x05!\x9cc\xd4\xf2s\xbe\xdd\x11\xc4^i\x82\xa4\xd2Ĕ\xe7R<<<<<<<<<<\xf2\xc7\x0f\xf1\x05p\x84\xf6\xa0\x13\xc3Y\xe0\xe0\x87k\rg\x81á\x82\xbfp\x1f\x8f\x8dP\r\xc0p\x16\xb8#\xb9\x0e\u0530\x9a\x01\x8d\xd6\x1f\xab\x19\xf0AJ^\xfe\x1b\xff\xc0\xd0h\xe5\xcc\xcb\x1d\xee\x12\x8aF'̭Tz\xb2\xbc\xdbK+\x80\a\xe3\"\x18\xc9?\x93\x1b\x01\xb2\x16,\x92\x7fI \x80\xd1\xeb\xff`\x94\x9fE\x00#\xf8\a\xa2Z\xa9\b\xfe\x05\x91\x18(\xc9\x1f>kNVSv0.|S\xf2\xb7{\xb5\x83]\xf0\x12\t\xdeC\x02\xb83\x9e\tF\xf3\xaf_\x1e\xaf\x1a`&\x10\xc9_\x13dA\xd1\xeb\x8f\x1e\xdd\bn<\xda\xf8\x19\x0e\x7f\xa2\xf8Wd\x02\xb0\x14\x7f'\xe2\x97M\x14\xe5\xe4\xefe\xbd\x99\xb3ݧ\xf2\x0f\xf2ocv\xd7JV{t\x02\x98\xe5F\x84\x12\xc1\f\xfcᆈ\xea\x0e5\x18\xb7A\f#9\x81\xac\x8d\xbfݛ[\xe2g\xb2?\x94\xe4\x0fB\x01\xbd\xe3\x83\xe0ߊ\xed\x811#\x98\x83_SG\xb8\xaf\xf2\x0fQ\xec\xca\xffc\xa6\x878o\x85쭐\xbd\x15\xb2\xb7B\xf6V\xc8\xde\n\xd9[!{+do\x85쭐\xbd\x15\xb2\xb7B\xfeB+\xe43\xb7\x01rD\xc8\x04\xff\x1d'\xe4\xdb )c\x03\xac\x15\x88\xe4\xaf\tJ\xc20\xac\xbf)~\x16\xf1\v\xe0\xdf\x18]\x7f&\xdfo)\xfe%\xbb\xf8M\xc4\xdf\v\xeb\x8f\xdaį\x8cN\xbe\x1a9\x972\x13\x96\xdf\x02\x8f\x8b\xdf\xf1\x89\xdf}\x01̲\x1d\x89\x12\xc1\f\xfc\x95\x14\xd1F\f4\xffbon\x8d\x7fb\x8c\xbf5\xcaߓ\xfa!s\xf3o\xc8=\x92m\xa6\xc3nZ\xc4`kT\xf8Ƈ\xf1\x9f\xe5+\n\xde\a\xbe\xabt\x01lI\x00\x97\x16\xc1,\xfc+P\x81p4?\xba5,\x8a\x7f\xfe~j\x86\x7f\xc8\xf7\xd6\xd4\xfcL\x87\xdfJ\xf1w\n\xb2\xa0)\xf9{\x11\xbf\x9a\x0eE\xb5\x16\x85\xaf\x87\xed\xb8\xd8\xf3\xc1<I\x16\x92-\x8ax\x82\x89\xf8!\x9eX\x02~\xa8'\x14\xc4O\xe3\a-\xccO\xe1}\x06\xf0W\xac6\x80L\xfc\xe1A\xa7\u0590QK\xc4\xcfZ\xe9!\x15\xbfJ\x8f\xaf\x87\xc7G1\xfd\xe0\xa4\xea\xc4p&\x98\x85?xbo\xe3\xa4\xe0@\U000e3ce1,\xebo\x81\xbf\xcb\xfb\xf6\x94\xfc\r\xb90\xca\xc1\xbf\xd2 ~\x13\xf17Z\xc5\xef\x17\xf8\a\xb1\xe7\xd5.~]\xfc\x8eA\xfc~$\x80Y\xb7\xe7J\x89`&\xfe5\xe0@\\c\xdc\a\xdc\x18\x14\xfd(\xfe\xfe\xfd\xd4\x04\x7f\xc9\xef/\xc3\xf55\xca\xc4\xd29\xfc\x1b\xf1\x8fj\x15\xbf\x87\xf8\xa3\xf0\r\xbbs\x7f(\xe7s\v\x84[ \x0e[ d\x1b\xe4^\xbaS\xb1FV;\x04\x19\xff\xf6\xf2\xfa\xf9\xd78)1\b\xf8\xa1[\xc4\b\xfe\xcb\xeb\xe7\x8b\x03\x7f5Z~&\xe6B\xfc\xf0R\x7f\x85\xf9{\xc9\x16\xaa\x12L'\xf2\xc3\xcaWf\xe4\x1f\xa4Z\xc9F\xdb\x1az\x06\xd83\xc0\xa7f\x80'\xf2\xb471\x9c\tf\xe2_\x00\x0eĭ\x8dg\x81\x99\xd6\x7f\xac\xfcI\xb3\xbf\xdf\xd8;\x9b۶\x81 \n[\xb9\xf8&(\aߥ\n\xe2\x12\xd4A\x94\n\xc2T\x10u\x10\x95\xe0t\xa0\x12X\x82܁K\xe0]\x17\xc67\xdd\x02\"C@\x11\x82\x88\xfb7\xf3f\xf9\xf6\xc1\x80\xf7@Z\x9f\x04/ߎfg\x9c\xf0#\x96>+ɏ\\\xf2,\x17\xbf\xb7J\x0fSƞ\x11_F|k\x8d\xf8\xdejqg\x17\xf80\xc7H0\x18\xffP6i3N4\x861\xbfz\xd4\x1b\x80\xff\x19\xa9Τ\x02?\\#\x88\xc2\xfc\xb0\r \n\xf1\xef\x01\xbb\xbd\xe5\xe6\xef\xc4\xe4\xb3>l\x85\xf5a\x19\x01\x9eo\x04\x18\xa1$\x95u$\x18\x89\x7f}9/\x7f\x8c\x13\xa5aɿ3l\x06bſ\xcas\x1b7\xfc\xa7\xfc\xb7\x84\xe6o\x9dEҚ\xcaZ\x1d\xe7\xe6\x7fC۴\xd2\x00\xd3\x00\xd3\x00g2\xc0R\xbf0\xf5D\xac[\x13\f\xc8\x7f\xd0\xec\x94\x06\xc0o\x9a\x06\x01\xf8\xf9\xd7\xc6\xdf͌?WZ\x01:\x7f\x0f\x90\xc2T\x9a\xff(\r\x13<mhh\x80i\x80i\x80\xa7\x1a`\x19\x8d,h\xb34\xc1\x80\xfc\xc7\xf4[\xb8\xe1Gx\x88j\xf2\xaf\xd3o\xe1\x8a\x1f\xda\x00g\xe6?9*\x03\x96\xca\xdfTd\f\xff\xc5\x7f(y\x00\x9b\x06\x98\x06\x98\x06\x18\xc4\x00\xcbB\xe6e7\x9f\xdd\x04\x03\xf2o/\xe7\xe5\xe7qRz\x18\xf3\x9b\xa7A(\xf3\xc3\x19\xe0\xc2\xfc\x885\x80K\xf1ko\\\xad\xf8_@[<\xe7\xe2o\xd0\x1a\xb7\xd0\x00\xd3\x00\xd3\x00\x172\xc0\xb2\b\xbcʩй\x9a`4\xfe\x17Mch̿\v\xbf\xa4*\xfe\x87J\xf9{1\x17s\xe0\xef<W\b\b\xe0\xf7\xd0\xea8\x96\xbf\x91|ߚ*=\xd0\x00\xd3\x00\xd3\x00\xdf3\xc0\xb2\b\xfct\x14\xc5(a\x82\x91\xf8\xd7\xda\xf9\x84\x86\xfc\x10\r\"\x94\xf8\xd1\x0e\xc1\x95䇏\xfef\xe4\xcf\xf9\xbe\xa1\xf2\xf7b\x12\xab\x1c\xc3\x06\x86\x87\xddx؍\x87\xdd|\x1fv\x8b6\xc0\xb2\b|s\xb4\x98\x0f&\xf8\xeb8\xc91\xc0\xf8\xf7\x97\xf3\xf2\xd38\xd1\x18F\xfc\xe6\x11`E\xfe\xe7\xf1\x17đ\x99ߕ\x01N\xe4\xcf\xf5\x9e!\xf3\xefi\x10i\x10i\x10\xeb4\x8837\xc0\x7f\fp\xe2C@[E\xdao\x82\xf1\xab\x17\xd57\xe0_i\xe6<\x03\xf2C)#\x7f\x1fw\x99;\xfe\xd6\xe9\xe1\xb7\x10\xfe\x96\xa9\x01L\r`j@}\xa9\x014\xc07\x06\xf8j\x11Lɉ+=\xf6\xf2\x1a\x8b\f \xfe\xe1@\xdc\xf7q\xa25\f\xf8a\xa2\xc0\x85\xf9aS \n\xf0\xa3\xd7\x00\xce\xc5\xef\xa5\xf3[,\x7fWs\xea\x03EQ\xf5+\xaa\x1f\xbf\xb4\xe7m\x81\x1eܽt\xe5y\xd5\xe8\xca\x03\xc2o\xd6]J\x91\x7f80\xf5q\x9c\xa0\x8c\x12\xfc\x8fO\uf2c4\xcb=\xf1\xaf\xbdGF'\xf0\xabwo4\xe0\xef\x99\xfa\xc0\xd4\a\xa6>0\xf5\xc1c\xeaCT\x04\xf8\xe6t\xecZ\x1e\x02֣\x95\x87\xaa\x8a\xf9\x05\xe2_YE\x99\x14\xf9\xa1\xd2 \f\xf8!\x95\xc2_CZ\xc0\x04\xfeꢿ\xd7\x1a\xf8i~i~i~i~=\x9b\xdfh\x03,\x8b\xe0\xafǧ\xf7/rZߢ\xb0\xfd\xf07\xb7\xc3k\xb0\x88\x82\x82\xf0\x1f\xc3/s\xc7\x0f\x95\x06a\xc0\xffP\x11\xbf\xdb\xf4\x87\x00\xfe\xde\xf2\xff\x92\xa2(\x8a\x9a\xa6\x0f\x89\u05cfр\x8d\xe4\x83uaWG\xa9\x93\xa2\xe4\x1b\x89\xc4 DC\xc8_\x8e\x1f\xd2\x00\xe7旯\x95\xdd)\x90?\xfa\xfdq\xc4\xdfZl\xc8)\x8a\xa2\xa80-\n\xe5\x875b\\V\xf1w\xfaK\xbd|\xddxD0}\xe4W\xe7\xdfy\xe92\x95\xc0\xbfE\xffl3\xf0\x1f\xd8M\x8bݴ\xd8M\xab\xaenZ4\xc04\xc04\xc0b\x80\xaf%\xf9\x9b[\xf9\t\xadu\xfa&_\x99\x9e\xbc\xb6\xd8$?\xf9\x03\xf8\xab0\xc0w\xf8\xddldh\x80i\x80i\x80i\x80i\x80i\x80\xa3\r𭤁\xc3J\x1e\x86\xb7ѡ^LO\xb5\xa7\x8b\xc9O\xfe\xff\xf0\xb7\xb5rS\x14EQ\x14EQ\x14EQ\x14EQ\x86\xfa=\x00N\xcb\x19\x1e\x1ag\x81M\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,3 +1,4 @@
-// Package images contains variables holding image files.
+// Package images contains variables holding image files &
+// atlas manifests, naming frames of each sprite sheet.
 // These files are generated by using file2byteslice.
 package images
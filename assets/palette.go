@@ -0,0 +1,86 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"strconv"
+)
+
+// Palette holds colors the game draws with,
+// besides those of its images.
+type Palette struct {
+	Background color.RGBA
+	// Text is color of messages & score.
+	Text color.RGBA
+	// Dim is color of seed & power bar.
+	Dim color.RGBA
+	Dot color.RGBA
+}
+
+// DefaultPalette returns colors of the classic game.
+func DefaultPalette() *Palette {
+	return &Palette{
+		Background: color.RGBA{0, 0, 0, 255},
+		Text:       color.RGBA{255, 255, 255, 255},
+		Dim:        color.RGBA{236, 240, 241, 255},
+		Dot:        color.RGBA{250, 233, 8, 200},
+	}
+}
+
+// ParsePalette reads a palette encoded as JSON, colors are
+// written as #rrggbb or #rrggbbaa. Colors missing from it
+// are kept as in DefaultPalette.
+func ParsePalette(data []byte) (*Palette, error) {
+	var colors struct {
+		Background string `json:"background"`
+		Text       string `json:"text"`
+		Dim        string `json:"dim"`
+		Dot        string `json:"dot"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if decodeErr := decoder.Decode(&colors); decodeErr != nil {
+		return nil, fmt.Errorf("palette: %v", decodeErr)
+	}
+
+	palette := DefaultPalette()
+	fields := []struct {
+		name  string
+		value string
+		color *color.RGBA
+	}{
+		{"background", colors.Background, &palette.Background},
+		{"text", colors.Text, &palette.Text},
+		{"dim", colors.Dim, &palette.Dim},
+		{"dot", colors.Dot, &palette.Dot},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		parsed, parsedErr := parseColor(field.value)
+		if parsedErr != nil {
+			return nil, fmt.Errorf("palette: %s %v", field.name, parsedErr)
+		}
+		*field.color = parsed
+	}
+	return palette, nil
+}
+
+// parseColor reads a color written as #rrggbb or #rrggbbaa.
+func parseColor(value string) (color.RGBA, error) {
+	if (len(value) != 7 && len(value) != 9) || value[0] != '#' {
+		return color.RGBA{}, fmt.Errorf("must be #rrggbb or #rrggbbaa, got %q", value)
+	}
+	digits := value[1:]
+	if len(digits) == 6 {
+		digits += "ff"
+	}
+	rgba, rgbaErr := strconv.ParseUint(digits, 16, 32)
+	if rgbaErr != nil {
+		return color.RGBA{}, fmt.Errorf("must be #rrggbb or #rrggbbaa, got %q", value)
+	}
+	return color.RGBA{uint8(rgba >> 24), uint8(rgba >> 16), uint8(rgba >> 8), uint8(rgba)}, nil
+}
@@ -0,0 +1,30 @@
+package assets
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePalette(t *testing.T) {
+	palette, parseErr := ParsePalette([]byte(`{"background": "#0b1a2e", "dot": "#ff8c00c8"}`))
+	assert.Nil(t, parseErr, "Should be nil")
+	assert.Equal(t, color.RGBA{0x0b, 0x1a, 0x2e, 0xff}, palette.Background, "Alpha should default to opaque")
+	assert.Equal(t, color.RGBA{0xff, 0x8c, 0x00, 0xc8}, palette.Dot, "Should keep alpha")
+	assert.Equal(t, DefaultPalette().Text, palette.Text, "Should keep default")
+}
+
+func TestParsePaletteInvalid(t *testing.T) {
+	cases := map[string]string{
+		`{"text": "white"}`:      `palette: text must be #rrggbb or #rrggbbaa, got "white"`,
+		`{"dot": "#ff8c0g"}`:     `palette: dot must be #rrggbb or #rrggbbaa, got "#ff8c0g"`,
+		`{"foreground": "#fff"}`: `palette: json: unknown field "foreground"`,
+	}
+	for palette, message := range cases {
+		_, parseErr := ParsePalette([]byte(palette))
+		if assert.NotNil(t, parseErr, palette) {
+			assert.Equal(t, message, parseErr.Error(), palette)
+		}
+	}
+}
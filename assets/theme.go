@@ -0,0 +1,198 @@
+package assets
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/skatiyar/pacman/assets/fonts"
+	"github.com/skatiyar/pacman/assets/images"
+)
+
+// ThemeLoader loads assets of a theme, a directory of files named
+// same as the embedded ones: skin.png, characters.png, powers.png
+// & walls.png with their .json atlas manifests, arcade-n.ttf, the
+// mp3 sounds & palette.json. Files missing from it are taken from
+// embedded defaults, so a theme changes only what it needs to.
+//
+// Sprites of a theme must keep sizes of the default ones, as the
+// game is laid out around them.
+type ThemeLoader struct {
+	dir string
+}
+
+// NewThemeLoader returns loader of theme in given
+// directory, empty dir loads embedded assets only.
+func NewThemeLoader(dir string) *ThemeLoader {
+	return &ThemeLoader{dir: dir}
+}
+
+// LoadAssets converts images of theme to ebiten
+// image format and loads its font & palette.
+func (t *ThemeLoader) LoadAssets() (*Assets, error) {
+	if statErr := t.stat(); statErr != nil {
+		return nil, statErr
+	}
+
+	skin, skinErr := t.loadSkin()
+	if skinErr != nil {
+		return nil, skinErr
+	}
+
+	font, fontErr := t.loadArcadeFont()
+	if fontErr != nil {
+		return nil, fontErr
+	}
+
+	characters, charactersErr := t.loadCharacters()
+	if charactersErr != nil {
+		return nil, charactersErr
+	}
+
+	powers, powersErr := t.loadPowers()
+	if powersErr != nil {
+		return nil, powersErr
+	}
+
+	walls, wallsErr := t.loadWalls()
+	if wallsErr != nil {
+		return nil, wallsErr
+	}
+
+	palette, paletteErr := t.loadPalette()
+	if paletteErr != nil {
+		return nil, paletteErr
+	}
+
+	return &Assets{
+		ArcadeFont: font,
+		Skin:       skin,
+		Characters: characters,
+		Powers:     powers,
+		Walls:      walls,
+		Palette:    palette,
+	}, nil
+}
+
+// stat checks that directory of theme exists, so
+// a mistyped theme does not pass for the default.
+func (t *ThemeLoader) stat() error {
+	if t.dir == "" {
+		return nil
+	}
+	info, infoErr := os.Stat(t.dir)
+	if infoErr != nil {
+		return fmt.Errorf("theme: %v", infoErr)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("theme: %s is not a directory", t.dir)
+	}
+	return nil
+}
+
+// file returns contents of named file of theme,
+// or embedded one if theme does not have it.
+func (t *ThemeLoader) file(name string, embedded []byte) ([]byte, error) {
+	if t.dir == "" {
+		return embedded, nil
+	}
+	data, dataErr := ioutil.ReadFile(filepath.Join(t.dir, name))
+	if os.IsNotExist(dataErr) {
+		return embedded, nil
+	}
+	if dataErr != nil {
+		return nil, fmt.Errorf("theme: %v", dataErr)
+	}
+	return data, nil
+}
+
+func (t *ThemeLoader) loadSkin() (*ebiten.Image, error) {
+	data, dataErr := t.file("skin.png", images.SkinPng)
+	if dataErr != nil {
+		return nil, dataErr
+	}
+
+	sImage, sImageErr := png.Decode(bytes.NewReader(data))
+	if sImageErr != nil {
+		return nil, fmt.Errorf("theme: skin.png: %v", sImageErr)
+	}
+
+	// frame around grid & header are laid out on default skin.
+	defaults, defaultsErr := png.DecodeConfig(bytes.NewReader(images.SkinPng))
+	if defaultsErr != nil {
+		return nil, defaultsErr
+	}
+	size := sImage.Bounds().Size()
+	if size.X != defaults.Width || size.Y != defaults.Height {
+		return nil, fmt.Errorf("theme: skin.png is %dx%d, want %dx%d",
+			size.X, size.Y, defaults.Width, defaults.Height)
+	}
+
+	return ebiten.NewImageFromImage(sImage, ebiten.FilterDefault)
+}
+
+func (t *ThemeLoader) loadArcadeFont() (*truetype.Font, error) {
+	data, dataErr := t.file("arcade-n.ttf", fonts.ArcadeTTF)
+	if dataErr != nil {
+		return nil, dataErr
+	}
+
+	font, fontErr := truetype.Parse(data)
+	if fontErr != nil {
+		return nil, fmt.Errorf("theme: arcade-n.ttf: %v", fontErr)
+	}
+	return font, nil
+}
+
+// loadAtlas loads named sprite sheet & its manifest, either
+// can come from theme. Manifest has to name every frame of
+// the embedded one, at same size.
+func (t *ThemeLoader) loadAtlas(name string, sheet, manifest []byte) (*Atlas, error) {
+	defaults, defaultsErr := ParseManifest(manifest)
+	if defaultsErr != nil {
+		return nil, defaultsErr
+	}
+
+	data, dataErr := t.file(name+".json", manifest)
+	if dataErr != nil {
+		return nil, dataErr
+	}
+	frames, framesErr := ParseManifest(data)
+	if framesErr != nil {
+		return nil, fmt.Errorf("theme: %s.json: %v", name, framesErr)
+	}
+	if fitsErr := frames.Fits(defaults); fitsErr != nil {
+		return nil, fmt.Errorf("theme: %s.json: %v", name, fitsErr)
+	}
+
+	png, pngErr := t.file(name+".png", sheet)
+	if pngErr != nil {
+		return nil, pngErr
+	}
+	atlas, atlasErr := LoadAtlas(png, frames)
+	if atlasErr != nil {
+		return nil, fmt.Errorf("theme: %s.png: %v", name, atlasErr)
+	}
+	return atlas, nil
+}
+
+func (t *ThemeLoader) loadPalette() (*Palette, error) {
+	data, dataErr := t.file("palette.json", nil)
+	if dataErr != nil {
+		return nil, dataErr
+	}
+	if data == nil {
+		return DefaultPalette(), nil
+	}
+
+	palette, paletteErr := ParsePalette(data)
+	if paletteErr != nil {
+		return nil, fmt.Errorf("theme: palette.json: %v", paletteErr)
+	}
+	return palette, nil
+}
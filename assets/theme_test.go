@@ -0,0 +1,33 @@
+package assets
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/skatiyar/pacman/assets/images"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThemeSingleChompFrame(t *testing.T) {
+	dir, dirErr := ioutil.TempDir("", "theme")
+	if !assert.Nil(t, dirErr, "Should be nil") {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	manifest, _ := ParseManifest(images.CharactersJSON)
+	manifest["pacman-chomp"] = manifest["pacman-chomp"][:1]
+	data, _ := json.Marshal(manifest)
+	writeErr := ioutil.WriteFile(filepath.Join(dir, "characters.json"), data, 0644)
+	if !assert.Nil(t, writeErr, "Should be nil") {
+		return
+	}
+
+	characters, loadErr := NewThemeLoader(dir).loadCharacters()
+	if assert.Nil(t, loadErr, "Should load theme with a single chomp frame") {
+		assert.Len(t, characters.PacmanChomp, 1, "Should keep frames of theme")
+	}
+}
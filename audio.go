@@ -19,58 +19,73 @@ const (
 	sampleRate = 48000
 )
 
-func NewAudio() (*Audio, error) {
+// NewAudio returns players of sounds of given theme.
+func NewAudio(theme *assets.ThemeLoader) (*Audio, error) {
 	audioCtx, err := audio.NewContext(sampleRate)
 	if err != nil {
 		return nil, err
 	}
 
-	sounds, err := assets.LoadSounds(audioCtx)
-	if err != nil {
+	a := &Audio{ctx: audioCtx}
+	if err := a.Load(theme); err != nil {
 		return nil, err
 	}
 
-	beginning, err := newAudioPlayer(audioCtx, sounds.Beginning)
-	if err != nil {
-		return nil, err
-	}
+	return a, nil
+}
 
-	chomp, err := newAudioPlayer(audioCtx, sounds.Chomp)
+// Load replaces players with ones of sounds of given theme,
+// players in use are closed. Audio context is kept, as only
+// one can be made.
+func (a *Audio) Load(theme *assets.ThemeLoader) error {
+	players, err := a.LoadPlayers(theme)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	return a.SetPlayers(players)
+}
 
-	death, err := newAudioPlayer(audioCtx, sounds.Death)
+// LoadPlayers returns players of sounds of given theme,
+// without putting them in use.
+func (a *Audio) LoadPlayers(theme *assets.ThemeLoader) (*AudioPlayers, error) {
+	sounds, err := theme.LoadSounds(a.ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	eatFlask, err := newAudioPlayer(audioCtx, sounds.EatFlask)
-	if err != nil {
-		return nil, err
+	// players opened before a sound fails are closed.
+	players := &AudioPlayers{}
+	for _, sound := range []struct {
+		player **audio.Player
+		src    io.ReadCloser
+	}{
+		{&players.Beginning, sounds.Beginning},
+		{&players.Chomp, sounds.Chomp},
+		{&players.Death, sounds.Death},
+		{&players.EatFlask, sounds.EatFlask},
+		{&players.EatGhost, sounds.EatGhost},
+		{&players.ExtraPac, sounds.ExtraPac},
+	} {
+		player, err := newAudioPlayer(a.ctx, sound.src)
+		if err != nil {
+			players.Close()
+			return nil, err
+		}
+		*sound.player = player
 	}
 
-	eatGhost, err := newAudioPlayer(audioCtx, sounds.EatGhost)
-	if err != nil {
-		return nil, err
-	}
+	return players, nil
+}
 
-	extraPac, err := newAudioPlayer(audioCtx, sounds.ExtraPac)
-	if err != nil {
-		return nil, err
+// SetPlayers puts given players in use, the ones they replace
+// are closed. Given players are in use even if closing fails.
+func (a *Audio) SetPlayers(players *AudioPlayers) error {
+	replaced := a.players
+	a.players = players
+	if replaced != nil {
+		return replaced.Close()
 	}
-
-	return &Audio{
-		ctx: audioCtx,
-		players: &AudioPlayers{
-			Beginning: beginning,
-			Chomp:     chomp,
-			Death:     death,
-			EatFlask:  eatFlask,
-			EatGhost:  eatGhost,
-			ExtraPac:  extraPac,
-		},
-	}, nil
+	return nil
 }
 
 type AudioPlayers struct {
@@ -82,6 +97,21 @@ type AudioPlayers struct {
 	ExtraPac  *audio.Player
 }
 
+// Close closes every player, ones never opened are skipped.
+func (p *AudioPlayers) Close() error {
+	for _, player := range []*audio.Player{
+		p.Beginning, p.Chomp, p.Death, p.EatFlask, p.EatGhost, p.ExtraPac,
+	} {
+		if player == nil {
+			continue
+		}
+		if err := player.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func newAudioPlayer(ctx *audio.Context, src io.ReadCloser) (*audio.Player, error) {
 	buffer, err := ioutil.ReadAll(src)
 	if err != nil {
@@ -1,11 +1,104 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/skatiyar/pacman"
+	"github.com/skatiyar/pacman/engine"
 )
 
 func main() {
-	game, gameErr := pacman.NewGame()
+	seed := flag.Int64("seed", 0, "start every run from given seed, to replay the same maze")
+	record := flag.String("record", pacman.DefaultReplayDir(), "directory to save replays of finished runs in, empty to save none")
+	replay := flag.String("replay", "", "play back a replay file instead of reading the keyboard")
+	rulesFile := flag.String("rules", "", "JSON file with game rules, to tune the game without rebuilding")
+	difficulty := flag.String("difficulty", "", "JSON file with difficulty curve, to tune the game without rebuilding, overrides rules file")
+	generator := flag.String("generator", "", "maze generator, one of eller, sidewinder & binarytree, overrides rules file")
+	columns := flag.Int("columns", 0, "maze width in cells, from 6 for a hard narrow maze to 20 for a casual wide one, overrides rules file")
+	theme := flag.String("theme", "", "directory of theme files, drawn & played over embedded images, font & sounds")
+	window := flag.String("window", "", "window size as WIDTHxHEIGHT, game is scaled to fit it, by default window fits the game")
+	fullscreen := flag.Bool("fullscreen", false, "start in fullscreen, F key switches between fullscreen & window")
+	dev := flag.Bool("dev", false, "reload theme & rules file into running game whenever they change")
+	flag.Parse()
+
+	options := make([]pacman.Option, 0)
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			options = append(options, pacman.WithSeed(*seed))
+		}
+	})
+	options = append(options, pacman.WithRecording(*record))
+
+	rules := engine.DefaultRules()
+	if *rulesFile != "" {
+		file, fileErr := os.Open(*rulesFile)
+		if fileErr != nil {
+			panic(fileErr)
+		}
+		loaded, rulesErr := engine.LoadRules(file)
+		file.Close()
+		if rulesErr != nil {
+			panic(rulesErr)
+		}
+		rules = loaded
+	}
+	options = append(options, pacman.WithRules(rules))
+	if *difficulty != "" {
+		file, fileErr := os.Open(*difficulty)
+		if fileErr != nil {
+			panic(fileErr)
+		}
+		table, tableErr := engine.LoadDifficulty(file)
+		file.Close()
+		if tableErr != nil {
+			panic(tableErr)
+		}
+		options = append(options, pacman.WithDifficulty(table))
+	}
+	if *generator != "" {
+		options = append(options, pacman.WithGenerator(*generator))
+	}
+	if *columns != 0 {
+		options = append(options, pacman.WithColumns(*columns))
+	}
+
+	if *theme != "" {
+		options = append(options, pacman.WithTheme(*theme))
+	}
+	if *window != "" {
+		width, height, sizeErr := parseSize(*window)
+		if sizeErr != nil {
+			fmt.Fprintf(flag.CommandLine.Output(), "invalid value %q for flag -window: %v\n", *window, sizeErr)
+			flag.Usage()
+			os.Exit(2)
+		}
+		options = append(options, pacman.WithWindowSize(width, height))
+	}
+	if *fullscreen {
+		options = append(options, pacman.WithFullscreen())
+	}
+	if *dev {
+		options = append(options, pacman.WithDevMode(*rulesFile))
+	}
+
+	if *replay != "" {
+		data, dataErr := ioutil.ReadFile(*replay)
+		if dataErr != nil {
+			panic(dataErr)
+		}
+		recorded := &engine.Replay{}
+		if replayErr := recorded.UnmarshalBinary(data); replayErr != nil {
+			panic(replayErr)
+		}
+		options = append(options, pacman.WithReplay(recorded))
+	}
+
+	game, gameErr := pacman.NewGameWithOptions(options...)
 	if gameErr != nil {
 		panic(gameErr)
 	}
@@ -14,3 +107,20 @@ func main() {
 		panic(runErr)
 	}
 }
+
+// parseSize reads a size given as WIDTHxHEIGHT, both positive.
+func parseSize(size string) (int, int, error) {
+	parts := strings.Split(size, "x")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("size must be given as WIDTHxHEIGHT")
+	}
+	width, widthErr := strconv.Atoi(parts[0])
+	if widthErr != nil || width <= 0 {
+		return 0, 0, fmt.Errorf("width must be a positive number, got %q", parts[0])
+	}
+	height, heightErr := strconv.Atoi(parts[1])
+	if heightErr != nil || height <= 0 {
+		return 0, 0, fmt.Errorf("height must be a positive number, got %q", parts[1])
+	}
+	return width, height, nil
+}
@@ -0,0 +1,186 @@
+package engine
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Braiding tunes loops & dead ends of the maze. Each row is
+// braided once row above it is generated, by knocking down
+// walls, so it only ever adds passages to what generator made.
+type Braiding struct {
+	// Loops is the least number of loops per cell, rows get
+	// extra passages till they have enough.
+	Loops float64 `json:"loops"`
+	// DeadEnds is the most dead ends a row may keep,
+	// as a fraction of its cells.
+	DeadEnds float64 `json:"deadEnds"`
+}
+
+// NoBraiding leaves rows as generator makes them.
+var NoBraiding = Braiding{Loops: 0, DeadEnds: 1}
+
+// SetBraiding changes braiding of rows,
+// for rows populated from now on.
+func (m *Maze) SetBraiding(braiding Braiding) {
+	m.braiding = braiding
+}
+
+// braid opens walls of a row, first of dead ends till there are
+// few enough, then anywhere till row has enough passages. Row
+// above has to be given, as walls are opened to north as well.
+func braid(cells, above []Cell, braiding Braiding, src *rand.Rand) {
+	if braiding.Loops <= 0 && braiding.DeadEnds >= 1 {
+		return
+	}
+
+	columns := len(cells)
+	maxDeadEnds := int(braiding.DeadEnds * float64(columns))
+	for {
+		deadEnds := make([]int, 0, columns)
+		for i := 0; i < columns; i++ {
+			if isDeadend(cells[i]) {
+				deadEnds = append(deadEnds, i)
+			}
+		}
+		if len(deadEnds) <= maxDeadEnds {
+			break
+		}
+		i := deadEnds[src.Intn(len(deadEnds))]
+		walls := braidableWalls(cells, i)
+		openWall(cells, above, i, walls[src.Intn(len(walls))])
+	}
+
+	// a row of a connected maze needs as many passages as it has
+	// cells, every passage more than that closes a loop.
+	passages := 0
+	for i := 0; i < columns; i++ {
+		if !cells[i].HasWall(North) {
+			passages += 1
+		}
+		if i+1 < columns && !cells[i].HasWall(East) {
+			passages += 1
+		}
+	}
+	target := int(math.Ceil(float64(columns) * (1 + braiding.Loops)))
+	for passages < target {
+		closed := make([][2]int, 0, 2*columns)
+		for i := 0; i < columns; i++ {
+			for _, dir := range braidableWalls(cells, i) {
+				if dir != West {
+					closed = append(closed, [2]int{i, int(dir)})
+				}
+			}
+		}
+		if len(closed) == 0 {
+			break
+		}
+		wall := closed[src.Intn(len(closed))]
+		openWall(cells, above, wall[0], Direction(wall[1]))
+		passages += 1
+	}
+}
+
+// braidableWalls returns walls of cell which can be knocked
+// down, walls to north, east & west, except sides of maze.
+// Walls to south are left, row below is already braided.
+func braidableWalls(cells []Cell, i int) []Direction {
+	walls := make([]Direction, 0, 3)
+	for _, dir := range []Direction{North, East, West} {
+		x, _ := neighbour(i, 0, dir)
+		if x >= 0 && x < len(cells) && cells[i].HasWall(dir) {
+			walls = append(walls, dir)
+		}
+	}
+	return walls
+}
+
+// openWall knocks down wall of cell in given direction,
+// on both sides of it. Row above is needed for north walls.
+func openWall(cells, above []Cell, i int, dir Direction) {
+	cells[i] = cells[i].Open(dir)
+	switch dir {
+	case North:
+		above[i] = above[i].Open(South)
+	case East:
+		cells[i+1] = cells[i+1].Open(West)
+	case West:
+		cells[i-1] = cells[i-1].Open(East)
+	}
+}
+
+// MazeMetrics describes shape of a section of maze.
+type MazeMetrics struct {
+	Cells    int
+	DeadEnds int
+	// Passages is number of open walls between two cells.
+	Passages int
+	// Loops is number of passages more than
+	// needed to connect cells of section.
+	Loops int
+}
+
+// DeadEndRatio returns fraction of cells which are dead ends.
+func (mm MazeMetrics) DeadEndRatio() float64 {
+	if mm.Cells == 0 {
+		return 0
+	}
+	return float64(mm.DeadEnds) / float64(mm.Cells)
+}
+
+// LoopDensity returns number of loops per cell.
+func (mm MazeMetrics) LoopDensity() float64 {
+	if mm.Cells == 0 {
+		return 0
+	}
+	return float64(mm.Loops) / float64(mm.Cells)
+}
+
+// Metrics measures rows from & upto of maze, which have to
+// be populated. Top row of maze is not braided yet, so it is
+// best left out.
+func (m *Maze) Metrics(from, upto int) MazeMetrics {
+	metrics := MazeMetrics{Cells: (upto - from) * m.columns}
+	for y := from; y < upto; y++ {
+		for x := 0; x < m.columns; x++ {
+			if isDeadend(m.row(y)[x]) {
+				metrics.DeadEnds += 1
+			}
+			if x+1 < m.columns && !m.row(y)[x].HasWall(East) {
+				metrics.Passages += 1
+			}
+			if y+1 < upto && !m.row(y)[x].HasWall(North) {
+				metrics.Passages += 1
+			}
+		}
+	}
+
+	// loops = passages - cells + components, for any graph.
+	components := 0
+	seen := newFlags(upto-from, m.columns)
+	for y := from; y < upto; y++ {
+		for x := 0; x < m.columns; x++ {
+			if seen[y-from][x] {
+				continue
+			}
+			components += 1
+			queue := [][2]int{{x, y}}
+			seen[y-from][x] = true
+			for len(queue) > 0 {
+				cx, cy := queue[0][0], queue[0][1]
+				queue = queue[1:]
+				for dir := North; dir <= West; dir++ {
+					nx, ny := neighbour(cx, cy, dir)
+					if nx < 0 || nx >= m.columns || ny < from || ny >= upto ||
+						seen[ny-from][nx] || m.row(cy)[cx].HasWall(dir) {
+						continue
+					}
+					seen[ny-from][nx] = true
+					queue = append(queue, [2]int{nx, ny})
+				}
+			}
+		}
+	}
+	metrics.Loops = metrics.Passages - metrics.Cells + components
+	return metrics
+}
@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	maze, mazeErr := UnmarshalMaze([]byte(`+--+--+--+--+--+--+--+--+--+--+
+|                             |
++--+--+--+--+--+--+--+--+--+  +
+|                             |
++--+--+--+--+--+--+--+--+--+  +
+|                             |
++--+--+--+--+--+--+--+--+--+--+
+`), randSrc())
+	assert.Nil(t, mazeErr, "Should be nil")
+	metrics := maze.Metrics(0, 3)
+	assert.Equal(t, MazeMetrics{Cells: 30, DeadEnds: 3, Passages: 29, Loops: 0}, metrics, "Should be equal")
+	assert.Equal(t, 0.1, metrics.DeadEndRatio(), "Should be equal")
+
+	// passage down to row 0 is outside of section.
+	assert.Equal(t, MazeMetrics{Cells: 20, DeadEnds: 2, Passages: 19, Loops: 0}, maze.Metrics(1, 3), "Should be equal")
+}
+
+// TestBraiding measures mazes of many seeds,
+// for every generator.
+func TestBraiding(t *testing.T) {
+	braiding := Braiding{Loops: 0.2, DeadEnds: 0.1}
+	for name, generator := range Generators {
+		plain, braided := MazeMetrics{}, MazeMetrics{}
+		for seed := int64(0); seed < 20; seed++ {
+			for _, metrics := range []*MazeMetrics{&plain, &braided} {
+				maze := NewMaze(100, DefaultColumns, rand.New(rand.NewSource(seed)))
+				maze.SetGenerator(generator)
+				if metrics == &braided {
+					maze.SetBraiding(braiding)
+				}
+				maze.Populate()
+				assert.Nil(t, maze.Validate(), name)
+
+				// top row is not braided yet.
+				section := maze.Metrics(0, 99)
+				metrics.Cells += section.Cells
+				metrics.DeadEnds += section.DeadEnds
+				metrics.Loops += section.Loops
+			}
+		}
+		assert.True(t, plain.DeadEndRatio() > braiding.DeadEnds, name)
+		assert.True(t, braided.DeadEndRatio() <= braiding.DeadEnds, name)
+		assert.True(t, braided.LoopDensity() >= braiding.Loops-0.02, name)
+	}
+}
@@ -0,0 +1,69 @@
+package engine
+
+import "math/bits"
+
+// Cell holds walls of a maze cell, a bit for every
+// direction, in order North, East, South & West.
+type Cell uint8
+
+const (
+	WallNorth Cell = 1 << iota
+	WallEast
+	WallSouth
+	WallWest
+
+	// ClosedCell has walls on all four sides.
+	ClosedCell = WallNorth | WallEast | WallSouth | WallWest
+)
+
+// wallRunes are the runes String marks walls of a cell with,
+// in order of directions North, East, South & West.
+var wallRunes = [4]rune{'N', 'E', 'S', 'W'}
+
+// wallOf returns the bit of wall in given direction.
+func wallOf(dir Direction) Cell {
+	return 1 << uint(dir)
+}
+
+// HasWall reports whether cell has a wall in given direction.
+func (c Cell) HasWall(dir Direction) bool {
+	return c&wallOf(dir) != 0
+}
+
+// Open returns the cell with wall in given direction removed.
+func (c Cell) Open(dir Direction) Cell {
+	return c &^ wallOf(dir)
+}
+
+// Close returns the cell with wall in given direction added.
+func (c Cell) Close(dir Direction) Cell {
+	return c | wallOf(dir)
+}
+
+// Walls returns number of walls of the cell.
+func (c Cell) Walls() int {
+	return bits.OnesCount8(uint8(c & ClosedCell))
+}
+
+// Exits returns directions without a wall, North first.
+func (c Cell) Exits() []Direction {
+	exits := make([]Direction, 0, 4)
+	for dir := North; dir <= West; dir++ {
+		if !c.HasWall(dir) {
+			exits = append(exits, dir)
+		}
+	}
+	return exits
+}
+
+// String returns walls of the cell as four runes, a letter for
+// a wall & '_' for an open side, i.e. "NE_W" is open to south.
+func (c Cell) String() string {
+	runes := []rune("____")
+	for dir := North; dir <= West; dir++ {
+		if c.HasWall(dir) {
+			runes[dir] = wallRunes[dir]
+		}
+	}
+	return string(runes)
+}
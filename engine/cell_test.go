@@ -0,0 +1,22 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCell(t *testing.T) {
+	cell := ClosedCell.Open(South)
+	assert.True(t, cell.HasWall(North), "Should have wall")
+	assert.False(t, cell.HasWall(South), "Should not have wall")
+	assert.Equal(t, 3, cell.Walls(), "Walls should be same")
+	assert.Equal(t, []Direction{South}, cell.Exits(), "Exits should be same")
+	assert.Equal(t, "NE_W", cell.String(), "Should be equal")
+	assert.Equal(t, ClosedCell, cell.Close(South), "Should be equal")
+
+	assert.True(t, isDeadend(cell), "Should be a dead end")
+	assert.False(t, isIntersection(WallEast|WallWest), "Corridor should not be an intersection")
+	assert.True(t, isIntersection(WallNorth|WallEast), "Corner should be an intersection")
+	assert.Equal(t, East, getExit(WallNorth|WallSouth), "Exit should be same")
+}
@@ -0,0 +1,138 @@
+package engine
+
+type Direction int
+type GhostType int
+type PowerType int
+
+// Data holds the state of a single run, it is
+// updated by Simulation & read by the renderers.
+//
+// Pacman, ghosts & powers are placed in world coordinates,
+// rows count up from first row of maze & pixels from its
+// bottom edge, so they keep their place as maze scrolls.
+type Data struct {
+	Seed    int64
+	Columns int
+	// Grid & Active hold rows in view, Grid[0] is
+	// world row Row, rows below it are gone.
+	Grid   [][]Cell
+	Active [][]bool
+	Row    int
+	// RowVersions changes for a row of Grid, whenever
+	// its walls do, so renderers can cache rows.
+	RowVersions []int
+	// ViewY is world position of bottom edge of view.
+	ViewY      float64
+	Lifes      int
+	Score      int
+	Pacman     Pacman
+	Ghosts     []Ghost
+	Powers     []Power
+	Invincible Timer
+	// Mode is the scheduled mode of ghosts,
+	// either ScatterMode or ChaseMode.
+	Mode  GhostMode
+	Level int
+
+	// depth is highest row pacman has reached.
+	depth int
+}
+
+const (
+	North Direction = iota
+	East
+	South
+	West
+)
+
+const (
+	Ghost1 GhostType = iota
+	Ghost2
+	Ghost3
+	Ghost4
+)
+
+const (
+	Life PowerType = iota
+	Invincibility
+)
+
+func NewData(rules *Rules) *Data {
+	return &Data{
+		Columns: rules.Columns,
+		Lifes:   rules.StartLifes,
+		Score:   1,
+		Level:   1,
+	}
+}
+
+// Cell returns walls of cell at given world row & column.
+func (d *Data) Cell(x, y int) Cell {
+	return d.Grid[y-d.Row][x]
+}
+
+// Explored reports whether dot of cell at
+// given world row & column is eaten.
+func (d *Data) Explored(x, y int) bool {
+	return d.Active[y-d.Row][x]
+}
+
+// Depth returns number of rows pacman has climbed,
+// highest row it has reached since start of run.
+func (d *Data) Depth() int {
+	return d.depth
+}
+
+type Position struct {
+	CellX, CellY int
+	PosX, PosY   float64
+	Direction    Direction
+}
+
+type Pacman struct {
+	Position
+}
+
+type Ghost struct {
+	Position
+	Kind GhostType
+	Mode GhostMode
+
+	// travel is distance ghost is yet to cover, it lets
+	// ghosts move at fractional speeds, in whole pixels.
+	travel float64
+	// homeX & homeY is world cell eyes return to.
+	homeX, homeY int
+}
+
+func NewGhost(x, y int, kind GhostType, dir Direction) Ghost {
+	return Ghost{
+		Position{
+			CellX:     x,
+			CellY:     y,
+			PosX:      float64((x * CellSize) + CellSize/2),
+			PosY:      float64((y * CellSize) + CellSize/2),
+			Direction: dir,
+		},
+		kind,
+		ScatterMode,
+		0,
+		0,
+		0,
+	}
+}
+
+type Power struct {
+	Position
+	Kind PowerType
+}
+
+func NewPower(x, y int, kind PowerType) Power {
+	return Power{
+		Position{
+			CellX: x,
+			CellY: y,
+		},
+		kind,
+	}
+}
@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+// DifficultyStep sets how hard the game is, once
+// player has climbed given number of rows.
+type DifficultyStep struct {
+	Rows int `json:"rows"`
+	// GhostSpeed in pixels per tick, frightened ghosts are
+	// slowed down by FrightenedSlowdown of rules.
+	GhostSpeed float64 `json:"ghostSpeed"`
+	// Ghosts is number of ghosts in play.
+	Ghosts int `json:"ghosts"`
+	// FrightenedSeconds is how long a flask lasts.
+	FrightenedSeconds float64 `json:"frightenedSeconds"`
+	// PowerRows places one power in every so many rows.
+	PowerRows int `json:"powerRows"`
+	// Openness is chance of a wall between two columns
+	// being torn down, bigger gives a more open maze.
+	Openness float64 `json:"openness"`
+}
+
+// DifficultyTable is a curve of difficulty over rows climbed,
+// values in between two steps are interpolated linearly, past
+// the last step they stay as in last step.
+type DifficultyTable []DifficultyStep
+
+// DefaultDifficulty starts as the game always played &
+// gets harder over first thousand rows.
+var DefaultDifficulty = DifficultyTable{
+	{Rows: 0, GhostSpeed: 1.0, Ghosts: 12, FrightenedSeconds: 10, PowerRows: 4, Openness: MagicNumber},
+	{Rows: 200, GhostSpeed: 1.2, Ghosts: 14, FrightenedSeconds: 8, PowerRows: 5, Openness: 0.6},
+	{Rows: 500, GhostSpeed: 1.4, Ghosts: 16, FrightenedSeconds: 6, PowerRows: 6, Openness: 0.5},
+	{Rows: 1000, GhostSpeed: 1.6, Ghosts: 18, FrightenedSeconds: 4, PowerRows: 8, Openness: 0.4},
+}
+
+// UnmarshalJSON decodes a step, every key of it has to be
+// given, as zero is a valid value for most of them.
+func (s *DifficultyStep) UnmarshalJSON(data []byte) error {
+	var step struct {
+		Rows              *int     `json:"rows"`
+		GhostSpeed        *float64 `json:"ghostSpeed"`
+		Ghosts            *int     `json:"ghosts"`
+		FrightenedSeconds *float64 `json:"frightenedSeconds"`
+		PowerRows         *int     `json:"powerRows"`
+		Openness          *float64 `json:"openness"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if decodeErr := decoder.Decode(&step); decodeErr != nil {
+		return decodeErr
+	}
+
+	switch {
+	case step.Rows == nil:
+		return fmt.Errorf("difficulty step is missing rows")
+	case step.GhostSpeed == nil:
+		return fmt.Errorf("difficulty step is missing ghostSpeed")
+	case step.Ghosts == nil:
+		return fmt.Errorf("difficulty step is missing ghosts")
+	case step.FrightenedSeconds == nil:
+		return fmt.Errorf("difficulty step is missing frightenedSeconds")
+	case step.PowerRows == nil:
+		return fmt.Errorf("difficulty step is missing powerRows")
+	case step.Openness == nil:
+		return fmt.Errorf("difficulty step is missing openness")
+	}
+	*s = DifficultyStep{
+		Rows:              *step.Rows,
+		GhostSpeed:        *step.GhostSpeed,
+		Ghosts:            *step.Ghosts,
+		FrightenedSeconds: *step.FrightenedSeconds,
+		PowerRows:         *step.PowerRows,
+		Openness:          *step.Openness,
+	}
+	return nil
+}
+
+// LoadDifficulty reads a table encoded as JSON array of steps,
+// and checks that it is valid. Same table can be given as
+// difficulty of a rules file.
+func LoadDifficulty(r io.Reader) (DifficultyTable, error) {
+	table := DifficultyTable{}
+	if decodeErr := json.NewDecoder(r).Decode(&table); decodeErr != nil {
+		return nil, fmt.Errorf("difficulty: %v", decodeErr)
+	}
+	if validateErr := table.Validate(); validateErr != nil {
+		return nil, validateErr
+	}
+	return table, nil
+}
+
+// Validate checks that table starts at zero rows, steps are in
+// increasing order of rows and values are in usable ranges.
+func (t DifficultyTable) Validate() error {
+	if len(t) == 0 {
+		return fmt.Errorf("difficulty: table has no steps")
+	}
+	if t[0].Rows != 0 {
+		return fmt.Errorf("difficulty: first step must be at 0 rows, got %d", t[0].Rows)
+	}
+	for i, step := range t {
+		if i > 0 && step.Rows <= t[i-1].Rows {
+			return fmt.Errorf("difficulty: step %d: rows must increase, got %d after %d",
+				i, step.Rows, t[i-1].Rows)
+		}
+		if step.GhostSpeed <= 0 || step.GhostSpeed > CellSize/4 {
+			return fmt.Errorf("difficulty: step %d: ghostSpeed must be in (0, %d], got %v",
+				i, CellSize/4, step.GhostSpeed)
+		}
+		if step.Ghosts < 0 {
+			return fmt.Errorf("difficulty: step %d: ghosts can not be negative, got %d", i, step.Ghosts)
+		}
+		if step.FrightenedSeconds < 0 {
+			return fmt.Errorf("difficulty: step %d: frightenedSeconds can not be negative, got %v",
+				i, step.FrightenedSeconds)
+		}
+		if step.PowerRows < 1 {
+			return fmt.Errorf("difficulty: step %d: powerRows must be at least 1, got %d", i, step.PowerRows)
+		}
+		if step.Openness < 0 || step.Openness > 1 {
+			return fmt.Errorf("difficulty: step %d: openness must be in [0, 1], got %v", i, step.Openness)
+		}
+	}
+	return nil
+}
+
+// At returns difficulty after climbing given number of rows.
+func (t DifficultyTable) At(rows int) DifficultyStep {
+	if len(t) == 0 {
+		return DefaultDifficulty.At(rows)
+	}
+
+	i := 0
+	for i+1 < len(t) && t[i+1].Rows <= rows {
+		i += 1
+	}
+	if i+1 == len(t) {
+		step := t[i]
+		step.Rows = rows
+		return step
+	}
+
+	from, to := t[i], t[i+1]
+	f := float64(rows-from.Rows) / float64(to.Rows-from.Rows)
+	lerp := func(a, b float64) float64 {
+		return a + (b-a)*f
+	}
+
+	return DifficultyStep{
+		Rows:              rows,
+		GhostSpeed:        lerp(from.GhostSpeed, to.GhostSpeed),
+		Ghosts:            int(math.Round(lerp(float64(from.Ghosts), float64(to.Ghosts)))),
+		FrightenedSeconds: lerp(from.FrightenedSeconds, to.FrightenedSeconds),
+		PowerRows:         int(math.Round(lerp(float64(from.PowerRows), float64(to.PowerRows)))),
+		Openness:          lerp(from.Openness, to.Openness),
+	}
+}
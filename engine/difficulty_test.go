@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDifficultyAt(t *testing.T) {
+	table := DifficultyTable{
+		{Rows: 0, GhostSpeed: 1.0, Ghosts: 10, FrightenedSeconds: 10, PowerRows: 4, Openness: 1.0},
+		{Rows: 100, GhostSpeed: 2.0, Ghosts: 20, FrightenedSeconds: 0, PowerRows: 8, Openness: 0.5},
+	}
+	assert.Equal(t, table[0], table.At(0), "Should be first step")
+	assert.Equal(t, DifficultyStep{
+		Rows: 50, GhostSpeed: 1.5, Ghosts: 15, FrightenedSeconds: 5, PowerRows: 6, Openness: 0.75,
+	}, table.At(50), "Should be half way")
+	assert.Equal(t, 20, table.At(1000).Ghosts, "Should stay at last step")
+}
+
+func TestDifficultyGhosts(t *testing.T) {
+	rules := DefaultRules()
+	rules.Difficulty = DifficultyTable{
+		{Rows: 0, GhostSpeed: 1.5, Ghosts: 16, FrightenedSeconds: 2, PowerRows: 8, Openness: 0.7},
+	}
+	sim := NewSimulation(1, rules)
+	defer sim.Close()
+	assert.Equal(t, 16, len(sim.Data().Ghosts), "Ghosts should be same")
+	assert.Equal(t, 3, len(sim.Data().Powers), "Powers should be same")
+	assert.Equal(t, 1.5, sim.ghostSpeed(ChaseMode), "Speed should be same")
+	assert.Equal(t, 0.75, sim.ghostSpeed(FrightenedMode), "Speed should be same")
+}
+
+func TestLoadDifficulty(t *testing.T) {
+	table, loadErr := LoadDifficulty(strings.NewReader(`[
+		{"rows": 0, "ghostSpeed": 1, "ghosts": 2, "frightenedSeconds": 5, "powerRows": 4, "openness": 0.5}
+	]`))
+	assert.Nil(t, loadErr, "Should be nil")
+	assert.Equal(t, DifficultyTable{
+		{Rows: 0, GhostSpeed: 1, Ghosts: 2, FrightenedSeconds: 5, PowerRows: 4, Openness: 0.5},
+	}, table, "Should load every key")
+
+	_, loadErr = LoadDifficulty(strings.NewReader(`[{"rows": 0, "ghostSpeed": 1}]`))
+	assert.EqualError(t, loadErr, "difficulty: difficulty step is missing ghosts", "Should reject partial step")
+}
+
+func TestDifficultyFollowsDepth(t *testing.T) {
+	sim := NewSimulation(1, nil)
+	defer sim.Close()
+	data := sim.Data()
+	src := randSrc()
+	input := Input{}
+	for i := 0; i < 10000; i++ {
+		if i%40 == 0 {
+			k := src.Intn(3)
+			input = Input{Up: k == 0, Left: k == 1, Right: k == 2}
+		}
+		sim.Step(input)
+		if i == 5000 {
+			rules := DefaultRules()
+			rules.Difficulty = DifficultyTable{
+				{Rows: 0, GhostSpeed: 1, Ghosts: 4, FrightenedSeconds: 5, PowerRows: 4, Openness: 0.5},
+				{Rows: 100, GhostSpeed: 2, Ghosts: 8, FrightenedSeconds: 5, PowerRows: 4, Openness: 0.5},
+			}
+			assert.Nil(t, sim.SetRules(rules), "Should be nil")
+		}
+		assert.Equal(t, sim.rules.Difficulty.At(data.Depth()), sim.current, "Difficulty should be at depth")
+		assert.Equal(t, 1+data.Depth()/sim.rules.LevelRows, data.Level, "Level should be at depth")
+	}
+	assert.True(t, data.Depth() > 0, "Pacman should have climbed")
+}
@@ -0,0 +1,140 @@
+package engine
+
+import (
+	"math"
+	"math/rand"
+)
+
+// RowGenerator creates rows of a maze, one at a time.
+type RowGenerator interface {
+	// NextRow returns walls of a new row of given number of
+	// columns, placed above previous one, which is nil for first
+	// row of maze. Open south walls of new row connect it to
+	// previous row. Openness is chance of tearing down a wall
+	// between two columns.
+	//
+	// Every cell of previous row has to get a path up to new row,
+	// else player could get stranded once rows below are compacted.
+	NextRow(previous []Cell, columns int, openness float64, src *rand.Rand) []Cell
+}
+
+// Generators lists available row generators by name.
+var Generators = map[string]RowGenerator{
+	"eller":      Eller{},
+	"sidewinder": Sidewinder{},
+	"binarytree": BinaryTree{},
+}
+
+/*
+Eller is a modified version of Eller's algorithm
+http://weblog.jamisbuck.org/2010/12/29/maze-generation-eller-s-algorithm.
+Eller's algorithm creates a perfect maze, a perfect maze has only one path
+between any two cells. Secondly to create next row, it requires knowledge
+of current row only. Giving us ability to create maze with infinite rows.
+
+Current implementation has been modified to give a non-perfect maze i.e.
+it can have more than one path between any two cells.
+*/
+type Eller struct{}
+
+// NextRow connects every horizontal passage of previous row
+// to new row, at one or more random cells, and merges columns
+// of new row to create passages.
+func (Eller) NextRow(previous []Cell, columns int, openness float64, src *rand.Rand) []Cell {
+	next := closedRow(columns)
+	if previous != nil {
+		current := make([]int, 0)
+		for i := 0; i < columns; i++ {
+			if i+1 == columns || previous[i].HasWall(East) || previous[i+1].HasWall(West) {
+				current = append(current, i)
+				src.Shuffle(len(current), func(i, j int) {
+					current[i], current[j] = current[j], current[i]
+				})
+				offset := 1
+				if len(current) > 2 {
+					offset = 2
+				}
+				gates := int(math.Floor(src.Float64()*(float64(len(current))/2))) + offset
+				for j := 0; j < gates; j++ {
+					next[current[j]] = next[current[j]].Open(South)
+				}
+				current = current[:0]
+			} else {
+				current = append(current, i)
+			}
+		}
+	}
+	mergeColumns(next, openness, src)
+	return next
+}
+
+/*
+Sidewinder is the sidewinder algorithm
+http://weblog.jamisbuck.org/2011/2/3/maze-generation-sidewinder-algorithm,
+turned upside down. Every horizontal passage of previous row gets exactly
+one way up, from a random cell. It gives a perfect maze, with longer dead
+ends than Eller.
+*/
+type Sidewinder struct{}
+
+// NextRow connects every horizontal passage of previous row
+// to new row at one random cell, and merges columns of new row.
+func (Sidewinder) NextRow(previous []Cell, columns int, openness float64, src *rand.Rand) []Cell {
+	next := closedRow(columns)
+	if previous != nil {
+		start := 0
+		for i := 0; i < columns; i++ {
+			if previous[i].HasWall(East) {
+				gate := start + src.Intn(i-start+1)
+				next[gate] = next[gate].Open(South)
+				start = i + 1
+			}
+		}
+	}
+	mergeColumns(next, openness, src)
+	return next
+}
+
+/*
+BinaryTree is the binary tree algorithm
+http://weblog.jamisbuck.org/2011/2/1/maze-generation-binary-tree-algorithm,
+every cell either opens to its east or to north. A cell of previous row
+with a wall to its east, opens north. It gives a perfect maze, biased
+towards a long corridor along east side.
+*/
+type BinaryTree struct{}
+
+// NextRow opens north wall of every cell of previous row
+// which has a wall to its east, and merges columns of new row.
+func (BinaryTree) NextRow(previous []Cell, columns int, openness float64, src *rand.Rand) []Cell {
+	next := closedRow(columns)
+	if previous != nil {
+		for i := 0; i < columns; i++ {
+			if previous[i].HasWall(East) {
+				next[i] = next[i].Open(South)
+			}
+		}
+	}
+	mergeColumns(next, openness, src)
+	return next
+}
+
+func closedRow(columns int) []Cell {
+	row := make([]Cell, columns)
+	for i := 0; i < columns; i++ {
+		row[i] = ClosedCell
+	}
+	return row
+}
+
+// mergeColumns decides whether to remove
+// walls between two columns or not, to create
+// horizontal passages in the row.
+func mergeColumns(row []Cell, openness float64, src *rand.Rand) {
+	for i := 0; i < len(row)-1; i++ {
+		if src.Float32() < float32(openness) {
+			row[i] = row[i].Open(East)
+			row[i+1] = row[i+1].Open(West)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinaryTree(t *testing.T) {
+	// with every column merged, only east most cell opens north.
+	maze := NewMaze(3, DefaultColumns, randSrc())
+	maze.SetGenerator(BinaryTree{})
+	maze.SetOpenness(1)
+	maze.Populate()
+	assert.Equal(t, mazeFixture(t, `
++--+--+--+--+--+--+--+--+--+--+
+|                             |
++--+--+--+--+--+--+--+--+--+  +
+|                             |
++--+--+--+--+--+--+--+--+--+  +
+|                             |
++--+--+--+--+--+--+--+--+--+--+
+`), maze.Get(0, 3), "Should be equal")
+}
+
+func TestGeneratorRules(t *testing.T) {
+	rules := DefaultRules()
+	rules.Generator = "sidewinder"
+	sim := NewSimulation(1, rules)
+	defer sim.Close()
+	for i := 0; i < 2000; i++ {
+		sim.Step(Input{Up: i%100 < 50, Right: i%100 >= 50})
+	}
+	assert.Nil(t, sim.maze.Validate(), "Should be nil")
+	eller, sidewinder := NewSimulation(1, nil), NewSimulation(1, rules)
+	defer eller.Close()
+	defer sidewinder.Close()
+	assert.NotEqual(t, eller.Data().Grid, sidewinder.Data().Grid, "Should not be equal")
+}
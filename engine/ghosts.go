@@ -0,0 +1,96 @@
+package engine
+
+import (
+	"math"
+	"math/rand"
+)
+
+// GhostStrategy picks the cell a ghost heads for, while it
+// is chasing pacman. Ghost is the index of ghost in data.
+// Target can lie outside the grid, ghosts move to the open
+// neighbour closest to it.
+type GhostStrategy func(ghost int, data *Data, src *rand.Rand) (cellX, cellY int)
+
+// defaultGhostStrategies gives each ghost the personality
+// of its arcade counterpart, matching sprite colours.
+var defaultGhostStrategies = map[GhostType]GhostStrategy{
+	Ghost1: Wander, // orange
+	Ghost2: Chase,  // red
+	Ghost3: Ambush, // pink
+	Ghost4: Flank,  // cyan
+}
+
+const (
+	// ambushAhead is number of cells ahead of pacman
+	// an ambushing ghost aims for.
+	ambushAhead = 4
+	// wanderRadius is distance in cells, under which
+	// a wandering ghost stops chasing pacman.
+	wanderRadius = 8
+)
+
+// Chase targets the cell pacman is in.
+func Chase(ghost int, data *Data, src *rand.Rand) (int, int) {
+	return data.Pacman.CellX, data.Pacman.CellY
+}
+
+// Ambush targets a few cells ahead of pacman, in the
+// direction pacman is heading, to cut it off.
+func Ambush(ghost int, data *Data, src *rand.Rand) (int, int) {
+	return cellAhead(data.Pacman, ambushAhead)
+}
+
+// Flank targets the cell opposite to nearest chasing (red)
+// ghost, mirrored around a point two cells ahead of pacman,
+// so together they close in from both sides. With no chasing
+// ghost around, it chases pacman itself.
+func Flank(ghost int, data *Data, src *rand.Rand) (int, int) {
+	pivotX, pivotY := cellAhead(data.Pacman, 2)
+
+	partner, partnerDist := -1, math.MaxFloat64
+	for i := 0; i < len(data.Ghosts); i++ {
+		if i == ghost || data.Ghosts[i].Kind != Ghost2 {
+			continue
+		}
+		dist := cellDistance(data.Ghosts[ghost].CellX, data.Ghosts[ghost].CellY,
+			data.Ghosts[i].CellX, data.Ghosts[i].CellY)
+		if dist < partnerDist {
+			partner, partnerDist = i, dist
+		}
+	}
+	if partner < 0 {
+		return Chase(ghost, data, src)
+	}
+
+	return 2*pivotX - data.Ghosts[partner].CellX,
+		2*pivotY - data.Ghosts[partner].CellY
+}
+
+// Wander chases pacman from far away, but once close
+// it loses interest and heads for a random cell.
+func Wander(ghost int, data *Data, src *rand.Rand) (int, int) {
+	if cellDistance(data.Ghosts[ghost].CellX, data.Ghosts[ghost].CellY,
+		data.Pacman.CellX, data.Pacman.CellY) > wanderRadius {
+		return Chase(ghost, data, src)
+	}
+	return src.Intn(data.Columns), data.Row + src.Intn(len(data.Grid))
+}
+
+func cellAhead(pacman Pacman, cells int) (int, int) {
+	x, y := pacman.CellX, pacman.CellY
+	switch pacman.Direction {
+	case North:
+		y += cells
+	case East:
+		x += cells
+	case South:
+		y -= cells
+	case West:
+		x -= cells
+	}
+	return x, y
+}
+
+func cellDistance(x1, y1, x2, y2 int) float64 {
+	return math.Sqrt(math.Pow(float64(x1-x2), 2) + math.Pow(float64(y1-y2), 2))
+}
@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func strategyData() *Data {
+	data := NewData(DefaultRules())
+	data.Grid = make([][]Cell, 24)
+	data.Pacman = Pacman{Position{CellX: 4, CellY: 5, Direction: North}}
+	data.Ghosts = []Ghost{
+		NewGhost(6, 5, Ghost2, West),
+		NewGhost(0, 0, Ghost4, North),
+		NewGhost(4, 20, Ghost1, South),
+	}
+	return data
+}
+
+func TestChase(t *testing.T) {
+	x, y := Chase(0, strategyData(), randSrc())
+	assert.Equal(t, []int{4, 5}, []int{x, y}, "Should target pacman")
+}
+
+func TestAmbush(t *testing.T) {
+	data := strategyData()
+	x, y := Ambush(0, data, randSrc())
+	assert.Equal(t, []int{4, 9}, []int{x, y}, "Should target ahead of pacman")
+	data.Pacman.Direction = West
+	x, y = Ambush(0, data, randSrc())
+	assert.Equal(t, []int{0, 5}, []int{x, y}, "Should target ahead of pacman")
+}
+
+func TestFlank(t *testing.T) {
+	data := strategyData()
+	x, y := Flank(1, data, randSrc())
+	// pivot is (4, 7), mirroring red ghost at (6, 5)
+	assert.Equal(t, []int{2, 9}, []int{x, y}, "Should mirror chasing ghost")
+	data.Ghosts[0].Kind = Ghost3
+	x, y = Flank(1, data, randSrc())
+	assert.Equal(t, []int{4, 5}, []int{x, y}, "Should target pacman")
+}
+
+func TestWander(t *testing.T) {
+	data := strategyData()
+	x, y := Wander(2, data, randSrc())
+	assert.Equal(t, []int{4, 5}, []int{x, y}, "Should target pacman")
+	data.Ghosts[2] = NewGhost(4, 7, Ghost1, South)
+	x, y = Wander(2, data, randSrc())
+	assert.True(t, x >= 0 && x < data.Columns && y >= 0 && y < len(data.Grid), "Should target a cell in grid")
+}
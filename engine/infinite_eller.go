@@ -0,0 +1,283 @@
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// MagicNumber for deciding whether or not
+// to tear down wall between two columns.
+const MagicNumber = 0.7
+
+const (
+	// DefaultColumns is the number of cells in a row of
+	// maze, unless rules ask for another width.
+	DefaultColumns = 10
+	// MinColumns & MaxColumns bound width of maze.
+	MinColumns = 6
+	MaxColumns = 20
+)
+
+/*
+Maze represents a maze of size rows x columns.
+
+Rows are created one at a time by a RowGenerator, each knowing only
+the row below it, giving us ability to create maze with infinite rows.
+Eller is used unless set otherwise.
+
+Rows are held in a ring, compacted rows make room for new ones, so
+memory stays same during an endless run. Rows are indexed from first
+row held, First gives absolute index of that row in the whole maze.
+Ring of a maze with a fixed capacity is never reallocated, see
+SetCapacity.
+*/
+type Maze struct {
+	// ring holds rows, row 0 of maze is at index start,
+	// versions holds version at which each row last changed.
+	ring     [][]Cell
+	versions []int
+	start    int
+	// fixed ring is never reallocated, oldest rows
+	// are evicted to make room for new ones.
+	fixed bool
+	// first is number of rows compacted so far.
+	first     int
+	rand      *rand.Rand
+	rows      int
+	columns   int
+	generator RowGenerator
+	braiding  Braiding
+	// openness is chance of tearing down a wall between
+	// two columns, MagicNumber unless set otherwise.
+	openness float64
+	// version changes every time the grid does,
+	// letting users know cached results are stale.
+	version int
+	// worker creates rows ahead of time, if started.
+	worker *rowWorker
+}
+
+// NewMaze returns an unintialized maze with
+// given number of rows & columns. Rand source is
+// used for all random operations, to give
+// deterministic results for a given seed.
+func NewMaze(rows, columns int, src *rand.Rand) *Maze {
+	return &Maze{
+		rand:      src,
+		ring:      make([][]Cell, rows, rows),
+		versions:  make([]int, rows, rows),
+		rows:      rows,
+		columns:   columns,
+		generator: Eller{},
+		braiding:  NoBraiding,
+		openness:  MagicNumber,
+	}
+}
+
+// NewPopulatedMaze returns a valid maze with given
+// number of rows & columns. It calls Populate after
+// calling NewMaze.
+func NewPopulatedMaze(rows, columns int, src *rand.Rand) *Maze {
+	maze := NewMaze(rows, columns, src)
+	maze.Populate()
+	return maze
+}
+
+// Version returns a number which changes whenever
+// Populate, GrowBy or Compact modify the grid.
+func (m *Maze) Version() int {
+	return m.version
+}
+
+// SetGenerator changes algorithm used to
+// create rows, for rows populated from now on.
+func (m *Maze) SetGenerator(generator RowGenerator) {
+	m.generator = generator
+}
+
+// SetOpenness changes chance of tearing down walls between
+// columns, for rows populated from now on.
+func (m *Maze) SetOpenness(openness float64) {
+	m.openness = openness
+}
+
+// RowVersion returns version at which walls of given
+// row last changed, rows keep it till they change again.
+func (m *Maze) RowVersion(i int) int {
+	return m.versions[(m.start+i)%len(m.ring)]
+}
+
+// SetCapacity fixes number of rows held by maze. Ring is
+// reallocated once, to given capacity, and never after,
+// GrowBy evicts oldest rows to make room for new ones,
+// moving First. Rows beyond capacity are evicted now.
+func (m *Maze) SetCapacity(capacity int) {
+	if m.rows > capacity {
+		m.Compact(m.rows - capacity)
+	}
+	ring, versions := make([][]Cell, capacity), make([]int, capacity)
+	for i := 0; i < m.rows; i++ {
+		ring[i], versions[i] = m.row(i), m.RowVersion(i)
+	}
+	m.ring, m.versions, m.start = ring, versions, 0
+	m.fixed = true
+}
+
+// Capacity returns number of rows ring can hold.
+func (m *Maze) Capacity() int {
+	return len(m.ring)
+}
+
+// Columns returns number of cells in a row of maze.
+func (m *Maze) Columns() int {
+	return m.columns
+}
+
+// First returns absolute index of row 0, rows
+// stay at same absolute index as maze is compacted.
+func (m *Maze) First() int {
+	return m.first
+}
+
+// Rows returns number of rows in maze.
+func (m *Maze) Rows() int {
+	return m.rows
+}
+
+// Get returns section of maze specified by indexes.
+// It reorders from & upto if from is greater than
+// upto. In case upto is bigger than number of rows,
+// maze is grown to have them. Rows share walls with
+// the maze, till they are compacted. Maze with a fixed
+// capacity can not give more rows than it holds.
+func (m *Maze) Get(from, upto int) [][]Cell {
+	if from > upto {
+		from, upto = upto, from
+	}
+	if m.fixed && upto > len(m.ring) {
+		panic(fmt.Sprintf("maze: rows upto %d do not fit capacity of %d", upto, len(m.ring)))
+	}
+	if upto > m.rows {
+		m.GrowBy(upto - m.rows)
+	}
+	grid := make([][]Cell, upto-from)
+	for i := range grid {
+		grid[i] = m.row(from + i)
+	}
+	return grid
+}
+
+// Populate creates a valid maze for given grid.
+func (m *Maze) Populate() {
+	for row := 0; row < m.rows; row++ {
+		m.populateRow(row)
+	}
+	m.version += 1
+}
+
+// GrowBy extends the grid by given number &
+// creates a valid maze out of new rows. With a
+// fixed capacity, oldest rows are evicted when
+// ring is full. Otherwise ring is reallocated
+// when it is too small, to at least double its
+// size, which suits mazes which are not played.
+func (m *Maze) GrowBy(n int) {
+	if m.fixed && m.rows+n > len(m.ring) {
+		for i := 0; i < n; i++ {
+			if m.rows == len(m.ring) {
+				m.Compact(1)
+			}
+			m.populateRow(m.rows)
+			m.rows += 1
+		}
+		m.version += 1
+		return
+	}
+	if m.rows+n > len(m.ring) {
+		size := 2 * len(m.ring)
+		if size < m.rows+n {
+			size = m.rows + n
+		}
+		ring, versions := make([][]Cell, size), make([]int, size)
+		for i := 0; i < m.rows; i++ {
+			ring[i], versions[i] = m.row(i), m.RowVersion(i)
+		}
+		m.ring, m.versions, m.start = ring, versions, 0
+	}
+	for i := m.rows; i < m.rows+n; i++ {
+		m.populateRow(i)
+	}
+	m.rows += n
+	m.version += 1
+}
+
+// Compact removes given number of rows from head of grid
+// and also creates walls in South direction to prevent player
+// from acessing previous rows.
+func (m *Maze) Compact(n int) {
+	if n >= m.rows {
+		m.first += m.rows
+		m.start = 0
+		m.rows = 0
+	} else {
+		m.first += n
+		m.start = (m.start + n) % len(m.ring)
+		row := m.row(0)
+		for i := 0; i < m.columns; i++ {
+			row[i] = row[i].Close(South)
+		}
+		m.touch(0)
+		m.rows -= n
+	}
+	m.version += 1
+}
+
+// row returns walls of given row, counted from row 0.
+func (m *Maze) row(i int) []Cell {
+	return m.ring[(m.start+i)%len(m.ring)]
+}
+
+// touch marks given row as changed by operation
+// in progress, which bumps version once done.
+func (m *Maze) touch(i int) {
+	m.versions[(m.start+i)%len(m.ring)] = m.version + 1
+}
+
+// populateRow creates the given row with generator,
+// opens north walls of previous row, where the new
+// row connects to it & braids previous row. Walls
+// are copied into row of ring, which is reused once
+// allocated. Rows are taken from worker instead, once
+// pregeneration is started.
+func (m *Maze) populateRow(row int) {
+	slot := (m.start + row) % len(m.ring)
+	if len(m.ring[slot]) != m.columns {
+		m.ring[slot] = make([]Cell, m.columns)
+	}
+	m.touch(row)
+	if m.worker != nil {
+		copy(m.ring[slot], m.worker.next())
+		return
+	}
+
+	var previous []Cell
+	if row > 0 {
+		previous = m.row(row - 1)
+	}
+	copy(m.ring[slot], m.generator.NextRow(previous, m.columns, m.openness, m.rand))
+	if previous != nil {
+		joinRows(previous, m.ring[slot])
+		braid(previous, m.ring[slot], m.braiding, m.rand)
+		m.touch(row - 1)
+	}
+}
+
+// joinRows opens north walls of row below,
+// where row above opens to south.
+func joinRows(below, above []Cell) {
+	for i := range below {
+		if !above[i].HasWall(South) {
+			below[i] = below[i].Open(North)
+		}
+	}
+}
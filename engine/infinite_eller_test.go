@@ -0,0 +1,150 @@
+package engine
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func randSrc() *rand.Rand {
+	return rand.New(rand.NewSource(1))
+}
+
+func TestNewMaze(t *testing.T) {
+	assert.NotNil(t, NewMaze(10, DefaultColumns, randSrc()), "Should not be nil.")
+}
+
+func TestRows(t *testing.T) {
+	maze := NewMaze(10, DefaultColumns, randSrc())
+	assert.NotNil(t, maze, "Should not be nil.")
+	assert.Equal(t, 10, maze.Rows(), "Rows should be same")
+}
+
+// mazeFixture returns grid of a maze drawn as
+// ASCII art, in format of Maze.MarshalText.
+func mazeFixture(t *testing.T, text string) [][]Cell {
+	maze, mazeErr := UnmarshalMaze([]byte(strings.TrimPrefix(text, "\n")), randSrc())
+	assert.Nil(t, mazeErr, "Should be nil")
+	return maze.Get(0, maze.Rows())
+}
+
+func TestPopulate(t *testing.T) {
+	result := mazeFixture(t, `
++--+--+--+--+--+--+--+--+--+--+
+|              |        |     |
++--+  +  +--+--+  +  +  +--+--+
+|     |                       |
++--+--+--+--+--+--+--+--+--+--+
+`)
+	maze := NewMaze(2, DefaultColumns, randSrc())
+	maze.Populate()
+	assert.Equal(t, 2, maze.Rows(), "Rows should be same")
+	assert.Equal(t, result, maze.Get(0, 2), "Should be equal")
+}
+
+func TestGrowBy(t *testing.T) {
+	maze := NewMaze(2, DefaultColumns, randSrc())
+	maze.Populate()
+	result := mazeFixture(t, `
++--+--+--+--+--+--+--+--+--+--+
+|              |        |     |
++--+  +  +--+--+  +  +  +--+--+
+|     |                       |
++--+--+--+--+--+--+--+--+--+--+
+`)
+	assert.Equal(t, 2, maze.Rows(), "Rows should be same")
+	assert.Equal(t, result, maze.Get(0, 2), "Should be equal")
+	maze.GrowBy(2)
+	result = mazeFixture(t, `
++--+--+--+--+--+--+--+--+--+--+
+|           |  |  |  |        |
++--+--+--+  +--+  +--+--+  +--+
+|                             |
++  +--+--+  +--+  +--+  +  +--+
+|              |        |     |
++--+  +  +--+--+  +  +  +--+--+
+|     |                       |
++--+--+--+--+--+--+--+--+--+--+
+`)
+	assert.Equal(t, 4, maze.Rows(), "Rows should be same")
+	assert.Equal(t, result, maze.Get(0, 4), "Should be equal")
+}
+
+func TestCompact(t *testing.T) {
+	whole := NewPopulatedMaze(200, DefaultColumns, randSrc())
+	maze := NewPopulatedMaze(32, DefaultColumns, randSrc())
+	for maze.First()+maze.Rows() < 200 {
+		maze.Compact(4)
+		if maze.Rows() < 28 {
+			maze.GrowBy(16)
+		}
+		// row 0 gets closed to south, rest is same as whole maze.
+		assert.Equal(t, whole.Get(maze.First()+1, maze.First()+24), maze.Get(1, 24), "Should be equal")
+	}
+	assert.Equal(t, 64, len(maze.ring), "Ring should only grow once")
+
+	maze.Compact(maze.Rows() + 4)
+	assert.Equal(t, 0, maze.Rows(), "Rows should be same")
+}
+
+func TestCapacity(t *testing.T) {
+	whole := NewPopulatedMaze(400, DefaultColumns, randSrc())
+	maze := NewMaze(0, DefaultColumns, randSrc())
+	maze.SetCapacity(44)
+	maze.GrowBy(32)
+	for maze.First()+maze.Rows() < 400 {
+		// growing past capacity evicts oldest rows.
+		maze.GrowBy(16)
+		assert.Equal(t, 44, maze.Capacity(), "Capacity should stay same")
+		assert.True(t, maze.Rows() <= 44, "Rows should fit capacity")
+		assert.Equal(t, whole.Get(maze.First()+1, maze.First()+maze.Rows()-1),
+			maze.Get(1, maze.Rows()-1), "Should be equal")
+	}
+	assert.Nil(t, maze.Validate(), "Should be nil")
+}
+
+func TestPregenerate(t *testing.T) {
+	whole := NewPopulatedMaze(41, DefaultColumns, randSrc())
+	maze := NewMaze(0, DefaultColumns, randSrc())
+	maze.Pregenerate(5, 2, func(row int) float64 {
+		return MagicNumber
+	})
+	defer maze.Close()
+
+	// rows are only handed over once finished, so all
+	// rows but top one of whole maze have to be same.
+	assert.Equal(t, whole.Get(0, 40), maze.Get(0, 40), "Should be equal")
+	maze.Compact(12)
+	assert.Equal(t, 12, maze.First(), "First should be same")
+	assert.Equal(t, 60, len(maze.Get(0, 60)), "Rows should be same")
+	assert.Nil(t, maze.Validate(), "Should be nil")
+}
+
+func TestPregenerateBehind(t *testing.T) {
+	whole := NewPopulatedMaze(41, DefaultColumns, randSrc())
+	maze := NewMaze(0, DefaultColumns, randSrc())
+	// no rows are kept ready, worker is always behind.
+	maze.Pregenerate(5, 0, func(row int) float64 {
+		return MagicNumber
+	})
+	defer maze.Close()
+
+	assert.Equal(t, whole.Get(0, 40), maze.Get(0, 40), "Should be equal")
+	assert.Equal(t, 40, maze.worker.inline, "Rows should be made without waiting")
+}
+
+func TestRowVersion(t *testing.T) {
+	maze := NewPopulatedMaze(8, DefaultColumns, randSrc())
+	second := maze.RowVersion(2)
+	maze.GrowBy(4)
+	// growing finishes top row only, rest stay same.
+	assert.Equal(t, second, maze.RowVersion(2), "Version should be same")
+	assert.NotEqual(t, second, maze.RowVersion(7), "Version should not be same")
+
+	third := maze.RowVersion(3)
+	maze.Compact(2)
+	assert.NotEqual(t, second, maze.RowVersion(0), "Row closed to south should change")
+	assert.Equal(t, third, maze.RowVersion(1), "Version should be same")
+}
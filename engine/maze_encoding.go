@@ -0,0 +1,270 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// maxEncodedRows bounds rows of a decoded
+// maze, far more than a run ever climbs.
+const maxEncodedRows = 1 << 20
+
+var mazeMagic = []byte("PACM")
+
+/*
+MarshalText encodes the maze as ASCII art, top row first, the
+same way it is seen in game. Every cell is 3 characters wide, walls
+between cells are drawn once & shared by both cells.
+
+	+--+--+--+
+	|  |     |
+	+  +--+  +
+	|        |
+	+--+--+--+
+
+An empty maze is a single line of walls, which keeps its width.
+Walls of neighbouring cells have to agree, else an error is returned.
+*/
+func (m *Maze) MarshalText() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, (2*m.rows+1)*(3*m.columns+2)))
+	if m.rows == 0 {
+		buf.WriteString(strings.Repeat("+--", m.columns) + "+\n")
+	}
+	for k := 0; k < m.rows; k++ {
+		row := m.rows - 1 - k
+		if k == 0 {
+			writeHorizontalWalls(buf, m.row(row), North)
+		}
+
+		for i := 0; i < m.columns; i++ {
+			west := m.row(row)[i].HasWall(West)
+			if i > 0 && west != m.row(row)[i-1].HasWall(East) {
+				return nil, fmt.Errorf("maze: row %d, column %d: west wall does not match east wall of column %d",
+					row, i, i-1)
+			}
+			if west {
+				buf.WriteString("|  ")
+			} else {
+				buf.WriteString("   ")
+			}
+		}
+		if m.row(row)[m.columns-1].HasWall(East) {
+			buf.WriteString("|\n")
+		} else {
+			buf.WriteString(" \n")
+		}
+
+		if row > 0 {
+			for i := 0; i < m.columns; i++ {
+				if m.row(row)[i].HasWall(South) != m.row(row - 1)[i].HasWall(North) {
+					return nil, fmt.Errorf("maze: row %d, column %d: south wall does not match north wall of row %d",
+						row, i, row-1)
+				}
+			}
+		}
+		writeHorizontalWalls(buf, m.row(row), South)
+	}
+	return buf.Bytes(), nil
+}
+
+// writeHorizontalWalls writes a line of walls
+// on given side of row.
+func writeHorizontalWalls(buf *bytes.Buffer, row []Cell, side Direction) {
+	for i := 0; i < len(row); i++ {
+		if row[i].HasWall(side) {
+			buf.WriteString("+--")
+		} else {
+			buf.WriteString("+  ")
+		}
+	}
+	buf.WriteString("+\n")
+}
+
+// UnmarshalText replaces grid of the maze with one encoded by
+// MarshalText. Trailing spaces of lines may be left out, so files
+// survive editors which strip them. Size of maze is bound same
+// as by UnmarshalBinary.
+func (m *Maze) UnmarshalText(text []byte) error {
+	lines := strings.Split(strings.TrimRight(strings.Replace(string(text), "\r\n", "\n", -1), "\n"), "\n")
+	if len(lines)%2 == 0 {
+		return fmt.Errorf("maze: expected an odd number of lines, got %d", len(lines))
+	}
+
+	// top border is never stripped, it ends in a corner.
+	columns := (len(lines[0]) - 1) / 3
+	width := 3*columns + 1
+	if columns < 1 || len(lines[0]) != width {
+		return fmt.Errorf("maze: line 1: expected 3 characters per column & 1 more, got %d", len(lines[0]))
+	}
+	if sizeErr := checkSize(uint64(columns), uint64(len(lines)/2)); sizeErr != nil {
+		return sizeErr
+	}
+	for j, line := range lines {
+		if len(line) > width {
+			return fmt.Errorf("maze: line %d: expected at most %d characters, got %d", j+1, width, len(line))
+		}
+		line += strings.Repeat(" ", width-len(line))
+		for c := 0; c < width; c++ {
+			var expected string
+			switch {
+			case j%2 == 0 && c%3 == 0:
+				expected = "+"
+			case j%2 == 0:
+				expected = "- "
+			case c%3 == 0:
+				expected = "| "
+			default:
+				expected = " "
+			}
+			if !strings.ContainsRune(expected, rune(line[c])) {
+				return fmt.Errorf("maze: line %d, character %d: expected one of %q, got %q",
+					j+1, c+1, expected, line[c])
+			}
+		}
+		if j%2 == 0 {
+			for i := 0; i < columns; i++ {
+				if line[3*i+1] != line[3*i+2] {
+					return fmt.Errorf("maze: line %d, column %d: wall is half drawn", j+1, i)
+				}
+			}
+		}
+		lines[j] = line
+	}
+
+	rows := len(lines) / 2
+	grid := make([][]Cell, rows, rows)
+	for k := 0; k < rows; k++ {
+		row := rows - 1 - k
+		grid[row] = make([]Cell, columns)
+		north, cells, south := lines[2*k], lines[2*k+1], lines[2*k+2]
+		for i := 0; i < columns; i++ {
+			walls := [4]bool{
+				north[3*i+1] == '-',
+				cells[3*i+3] == '|',
+				south[3*i+1] == '-',
+				cells[3*i] == '|',
+			}
+			for dir := North; dir <= West; dir++ {
+				if walls[dir] {
+					grid[row][i] = grid[row][i].Close(dir)
+				}
+			}
+		}
+	}
+
+	m.setGrid(grid, columns)
+	return nil
+}
+
+// MarshalBinary encodes the maze compactly, walls of a cell
+// take 4 bits in same order as Cell, so two cells fit in a byte.
+func (m *Maze) MarshalBinary() ([]byte, error) {
+	rowSize := (m.columns + 1) / 2
+	buf := bytes.NewBuffer(make([]byte, 0, len(mazeMagic)+2*binary.MaxVarintLen64+m.rows*rowSize))
+	scratch := make([]byte, binary.MaxVarintLen64)
+
+	buf.Write(mazeMagic)
+	buf.Write(scratch[:binary.PutUvarint(scratch, uint64(m.columns))])
+	buf.Write(scratch[:binary.PutUvarint(scratch, uint64(m.rows))])
+	for row := 0; row < m.rows; row++ {
+		for i := 0; i < m.columns; i += 2 {
+			bits := byte(m.row(row)[i] & ClosedCell)
+			if i+1 < m.columns {
+				bits |= byte(m.row(row)[i+1]&ClosedCell) << 4
+			}
+			buf.WriteByte(bits)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary replaces grid of the maze with one encoded
+// by MarshalBinary. Width has to be in [MinColumns, MaxColumns],
+// rows at most maxEncodedRows and data has to hold walls of
+// every row, nothing more.
+func (m *Maze) UnmarshalBinary(data []byte) error {
+	buf := bytes.NewReader(data)
+
+	magic := make([]byte, len(mazeMagic))
+	if _, readErr := buf.Read(magic); readErr != nil || !bytes.Equal(magic, mazeMagic) {
+		return errors.New("maze: not a maze file")
+	}
+
+	columns, columnsErr := binary.ReadUvarint(buf)
+	if columnsErr != nil {
+		return fmt.Errorf("maze: reading columns: %v", columnsErr)
+	}
+	rows, rowsErr := binary.ReadUvarint(buf)
+	if rowsErr != nil {
+		return fmt.Errorf("maze: reading rows: %v", rowsErr)
+	}
+	if sizeErr := checkSize(columns, rows); sizeErr != nil {
+		return sizeErr
+	}
+	// rows are bounded, so size of walls can not overflow.
+	rowSize := (columns + 1) / 2
+	if uint64(buf.Len()) != rows*rowSize {
+		return fmt.Errorf("maze: expected %d bytes of walls for %d rows, got %d",
+			rows*rowSize, rows, buf.Len())
+	}
+
+	grid := make([][]Cell, rows, rows)
+	for row := range grid {
+		grid[row] = make([]Cell, columns)
+		for i := 0; i < int(columns); i += 2 {
+			// length is checked above, reads can not fail.
+			bits, _ := buf.ReadByte()
+			grid[row][i] = Cell(bits) & ClosedCell
+			if i+1 < int(columns) {
+				grid[row][i+1] = Cell(bits>>4) & ClosedCell
+			}
+		}
+	}
+
+	m.setGrid(grid, int(columns))
+	return nil
+}
+
+// checkSize rejects decoded mazes too narrow or wide
+// to be played, or with more rows than are ever held.
+func checkSize(columns, rows uint64) error {
+	if columns < MinColumns || columns > MaxColumns {
+		return fmt.Errorf("maze: columns must be in [%d, %d], got %d", MinColumns, MaxColumns, columns)
+	}
+	if rows > maxEncodedRows {
+		return fmt.Errorf("maze: rows must be at most %d, got %d", maxEncodedRows, rows)
+	}
+	return nil
+}
+
+// setGrid replaces rows of maze with given ones.
+func (m *Maze) setGrid(grid [][]Cell, columns int) {
+	m.ring, m.start, m.first = grid, 0, 0
+	m.versions = make([]int, len(grid))
+	for i := range m.versions {
+		m.versions[i] = m.version + 1
+	}
+	m.rows = len(grid)
+	m.columns = columns
+	m.version += 1
+}
+
+// UnmarshalMaze decodes a maze encoded by either MarshalText or
+// MarshalBinary. Rand source is used for rows added by GrowBy.
+func UnmarshalMaze(data []byte, src *rand.Rand) (*Maze, error) {
+	maze := NewMaze(0, DefaultColumns, src)
+	if bytes.HasPrefix(data, mazeMagic) {
+		if unmarshalErr := maze.UnmarshalBinary(data); unmarshalErr != nil {
+			return nil, unmarshalErr
+		}
+		return maze, nil
+	}
+	if unmarshalErr := maze.UnmarshalText(data); unmarshalErr != nil {
+		return nil, unmarshalErr
+	}
+	return maze, nil
+}
@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMazeText(t *testing.T) {
+	maze := NewPopulatedMaze(24, DefaultColumns, randSrc())
+	text, textErr := maze.MarshalText()
+	assert.Nil(t, textErr, "Should be nil")
+
+	loaded, loadedErr := UnmarshalMaze(text, randSrc())
+	assert.Nil(t, loadedErr, "Should be nil")
+	assert.Equal(t, maze.Get(0, 24), loaded.Get(0, 24), "Should be equal")
+
+	// editors strip trailing spaces, and add \r on windows.
+	stripped := []byte{}
+	for _, line := range bytes.Split(text, []byte("\n")) {
+		stripped = append(stripped, bytes.TrimRight(line, " ")...)
+		stripped = append(stripped, '\r', '\n')
+	}
+	loaded, loadedErr = UnmarshalMaze(stripped, randSrc())
+	assert.Nil(t, loadedErr, "Should be nil")
+	assert.Equal(t, maze.Get(0, 24), loaded.Get(0, 24), "Should be equal")
+
+	// loaded mazes keep growing.
+	loaded.GrowBy(8)
+	assert.Equal(t, 32, loaded.Rows(), "Rows should be same")
+	assert.Nil(t, loaded.Validate(), "Should be nil")
+}
+
+func TestMazeWidth(t *testing.T) {
+	maze := NewPopulatedMaze(12, 7, randSrc())
+	assert.Nil(t, maze.Validate(), "Should be nil")
+
+	text, textErr := maze.MarshalText()
+	assert.Nil(t, textErr, "Should be nil")
+	loaded, loadedErr := UnmarshalMaze(text, randSrc())
+	assert.Nil(t, loadedErr, "Should be nil")
+	assert.Equal(t, 7, loaded.Columns(), "Columns should be same")
+	assert.Equal(t, maze.Get(0, 12), loaded.Get(0, 12), "Should be equal")
+
+	data, dataErr := maze.MarshalBinary()
+	assert.Nil(t, dataErr, "Should be nil")
+	loaded, loadedErr = UnmarshalMaze(data, randSrc())
+	assert.Nil(t, loadedErr, "Should be nil")
+	assert.Equal(t, 7, loaded.Columns(), "Columns should be same")
+	assert.Equal(t, maze.Get(0, 12), loaded.Get(0, 12), "Should be equal")
+}
+
+func TestMazeEmpty(t *testing.T) {
+	maze := NewMaze(0, 7, randSrc())
+	text, textErr := maze.MarshalText()
+	assert.Nil(t, textErr, "Should be nil")
+	assert.Equal(t, "+--+--+--+--+--+--+--+\n", string(text), "Should be a line of walls")
+	data, dataErr := maze.MarshalBinary()
+	assert.Nil(t, dataErr, "Should be nil")
+
+	for _, encoded := range [][]byte{text, data} {
+		loaded, loadedErr := UnmarshalMaze(encoded, randSrc())
+		if assert.Nil(t, loadedErr, "Should be nil") {
+			assert.Equal(t, 0, loaded.Rows(), "Rows should be same")
+			assert.Equal(t, 7, loaded.Columns(), "Columns should be same")
+		}
+	}
+}
+
+func TestMazeTextInvalid(t *testing.T) {
+	border := strings.Repeat("+--", DefaultColumns) + "+"
+	cases := map[string]string{
+		border + "\n|\n" + border + "\n|":                        "maze: expected an odd number of lines, got 4",
+		"+--+--+--+--+--+\n|\n+--+--+--+--+--+":                  "maze: columns must be in [6, 20], got 5",
+		border + strings.Repeat("+--", 11) + "\n\n" + border:     "maze: columns must be in [6, 20], got 21",
+		border + "\n|  #\n" + border:                             `maze: line 2, character 4: expected one of "| ", got '#'`,
+		"+-+" + border[3:] + "\n\n" + border:                     `maze: line 1, character 3: expected one of "- ", got '+'`,
+		"+- " + border[3:] + "\n\n" + border:                     "maze: line 1, column 0: wall is half drawn",
+		border + "\n|" + strings.Repeat(" ", 33) + "\n" + border: "maze: line 2: expected at most 31 characters, got 34",
+		"+--+-\n\n+--+-":                                         "maze: line 1: expected 3 characters per column & 1 more, got 5",
+	}
+	for text, message := range cases {
+		_, mazeErr := UnmarshalMaze([]byte(text), randSrc())
+		assert.EqualError(t, mazeErr, message, text)
+	}
+
+	maze := NewPopulatedMaze(2, DefaultColumns, randSrc())
+	maze.row(1)[3] = maze.row(1)[3].Close(East)
+	maze.row(1)[4] = maze.row(1)[4].Open(West)
+	_, textErr := maze.MarshalText()
+	assert.EqualError(t, textErr, "maze: row 1, column 4: west wall does not match east wall of column 3")
+}
+
+func TestMazeBinary(t *testing.T) {
+	maze := NewPopulatedMaze(24, DefaultColumns, randSrc())
+	// binary form keeps walls which do not match too.
+	maze.row(1)[3] = maze.row(1)[3].Close(East)
+	maze.row(1)[4] = maze.row(1)[4].Open(West)
+	data, dataErr := maze.MarshalBinary()
+	assert.Nil(t, dataErr, "Should be nil")
+	assert.Equal(t, 4+1+1+24*DefaultColumns/2, len(data), "Length should be same")
+
+	loaded, loadedErr := UnmarshalMaze(data, randSrc())
+	assert.Nil(t, loadedErr, "Should be nil")
+	assert.Equal(t, maze.Get(0, 24), loaded.Get(0, 24), "Should be equal")
+
+	_, loadedErr = UnmarshalMaze(data[:len(data)-1], randSrc())
+	assert.NotNil(t, loadedErr, "Should not be nil")
+	assert.NotNil(t, (&Maze{}).UnmarshalBinary([]byte("PAC")), "Should not be nil")
+}
+
+func TestMazeBinaryInvalid(t *testing.T) {
+	header := func(columns, rows uint64) []byte {
+		scratch := make([]byte, binary.MaxVarintLen64)
+		data := append([]byte{}, mazeMagic...)
+		data = append(data, scratch[:binary.PutUvarint(scratch, columns)]...)
+		return append(data, scratch[:binary.PutUvarint(scratch, rows)]...)
+	}
+	cases := map[string][]byte{
+		"maze: not a maze file":                                        []byte("PAC"),
+		"maze: reading columns: EOF":                                   mazeMagic,
+		"maze: columns must be in [6, 20], got 0":                      header(0, 1),
+		"maze: columns must be in [6, 20], got 21":                     header(21, 1),
+		"maze: reading rows: EOF":                                      header(10, 1)[:len(mazeMagic)+1],
+		"maze: rows must be at most 1048576, got 1048577":              header(10, maxEncodedRows+1),
+		"maze: rows must be at most 1048576, got 18446744073709551615": header(10, math.MaxUint64),
+		"maze: expected 10 bytes of walls for 2 rows, got 0":           header(10, 2),
+		"maze: expected 5 bytes of walls for 1 rows, got 6":            append(header(10, 1), 0, 0, 0, 0, 0, 0),
+	}
+	for message, data := range cases {
+		assert.EqualError(t, (&Maze{}).UnmarshalBinary(data), message, message)
+	}
+}
@@ -0,0 +1,148 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MazeError lists every problem found by Maze.Validate.
+type MazeError []string
+
+func (e MazeError) Error() string {
+	return "maze: " + strings.Join(e, "; ")
+}
+
+/*
+Validate checks the maze can be played through, it reports
+
+  - walls which neighbouring cells disagree on, or which open
+    out of the maze on its sides or bottom.
+  - cells which can not be reached from top row, so player
+    can not get into them from rows yet to be grown.
+  - regions with no path up to top row, which would
+    leave player stranded.
+
+It returns nil for a valid maze, a MazeError otherwise.
+*/
+func (m *Maze) Validate() error {
+	problems := make(MazeError, 0)
+	if m.rows == 0 {
+		return nil
+	}
+
+	for row := 0; row < m.rows; row++ {
+		if !m.row(row)[0].HasWall(West) {
+			problems = append(problems, fmt.Sprintf("row %d, column 0: west wall opens out of maze", row))
+		}
+		if !m.row(row)[m.columns-1].HasWall(East) {
+			problems = append(problems, fmt.Sprintf("row %d, column %d: east wall opens out of maze",
+				row, m.columns-1))
+		}
+		for i := 0; i < m.columns; i++ {
+			if i+1 < m.columns && m.row(row)[i].HasWall(East) != m.row(row)[i+1].HasWall(West) {
+				problems = append(problems, fmt.Sprintf("row %d, column %d: east wall does not match west wall of column %d",
+					row, i, i+1))
+			}
+			if row+1 < m.rows && m.row(row)[i].HasWall(North) != m.row(row + 1)[i].HasWall(South) {
+				problems = append(problems, fmt.Sprintf("row %d, column %d: north wall does not match south wall of row %d",
+					row, i, row+1))
+			}
+			if row == 0 && !m.row(row)[i].HasWall(South) {
+				problems = append(problems, fmt.Sprintf("row 0, column %d: south wall opens out of maze", i))
+			}
+		}
+	}
+
+	// walls are checked one cell at a time, a passage may be open
+	// from one side only. So cells reachable from top row & cells
+	// which reach top row are searched separately.
+	top := make([][2]int, 0, m.columns)
+	for i := 0; i < m.columns; i++ {
+		top = append(top, [2]int{i, m.rows - 1})
+	}
+	reachable := m.search(top, false)
+	reaching := m.search(top, true)
+
+	unreachable, first := 0, [2]int{}
+	for y := 0; y < m.rows; y++ {
+		for x := 0; x < m.columns; x++ {
+			if !reachable[y][x] {
+				if unreachable == 0 {
+					first = [2]int{x, y}
+				}
+				unreachable += 1
+			}
+		}
+	}
+	if unreachable > 0 {
+		problems = append(problems, fmt.Sprintf("%d cells can not be reached from top row, first at row %d, column %d",
+			unreachable, first[1], first[0]))
+	}
+
+	// stranded cells are grouped in regions, so a dead end
+	// pocket is reported once, instead of for every cell.
+	for y := 0; y < m.rows; y++ {
+		for x := 0; x < m.columns; x++ {
+			if reaching[y][x] {
+				continue
+			}
+			region := m.search([][2]int{{x, y}}, false)
+			size := 0
+			for ry := 0; ry < m.rows; ry++ {
+				for rx := 0; rx < m.columns; rx++ {
+					if region[ry][rx] && !reaching[ry][rx] {
+						reaching[ry][rx] = true
+						size += 1
+					}
+				}
+			}
+			problems = append(problems, fmt.Sprintf("region of %d cells at row %d, column %d has no path up to top row",
+				size, y, x))
+		}
+	}
+
+	if len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+// search marks cells reachable from given cells, moving through
+// open walls of the cell being left. When reverse is set it marks
+// cells from which given cells can be reached instead.
+func (m *Maze) search(from [][2]int, reverse bool) [][]bool {
+	seen := newFlags(m.rows, m.columns)
+	queue := make([][2]int, 0, m.rows*m.columns)
+	for _, cell := range from {
+		seen[cell[1]][cell[0]] = true
+		queue = append(queue, cell)
+	}
+	for len(queue) > 0 {
+		x, y := queue[0][0], queue[0][1]
+		queue = queue[1:]
+		for dir := North; dir <= West; dir++ {
+			nx, ny := neighbour(x, y, dir)
+			if nx < 0 || nx >= m.columns || ny < 0 || ny >= m.rows || seen[ny][nx] {
+				continue
+			}
+			open := !m.row(y)[x].HasWall(dir)
+			if reverse {
+				open = !m.row(ny)[nx].HasWall(getOppositeDirection(dir))
+			}
+			if open {
+				seen[ny][nx] = true
+				queue = append(queue, [2]int{nx, ny})
+			}
+		}
+	}
+	return seen
+}
+
+// newFlags returns a grid of flags, one for every cell.
+func newFlags(rows, columns int) [][]bool {
+	flags := make([][]bool, rows, rows)
+	for i := 0; i < rows; i++ {
+		flags[i] = make([]bool, columns)
+	}
+	return flags
+}
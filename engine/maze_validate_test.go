@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, NewPopulatedMaze(64, DefaultColumns, randSrc()).Validate(), "Should be nil")
+	assert.Nil(t, NewMaze(0, DefaultColumns, randSrc()).Validate(), "Should be nil")
+
+	// a pocket in the middle, with no way in or out.
+	maze, mazeErr := UnmarshalMaze([]byte(`+--+--+--+--+--+--+--+--+--+--+
+|                             |
++  +--+--+--+--+--+--+--+--+--+
+|  |     |                    |
++  +--+--+--+--+--+--+--+--+  +
+|                             |
++--+--+--+--+--+--+--+--+--+--+
+`), randSrc())
+	assert.Nil(t, mazeErr, "Should be nil")
+	assert.EqualError(t, maze.Validate(), "maze: "+
+		"2 cells can not be reached from top row, first at row 1, column 1; "+
+		"region of 2 cells at row 1, column 1 has no path up to top row")
+
+	maze = NewPopulatedMaze(4, DefaultColumns, randSrc())
+	maze.row(1)[3] = maze.row(1)[3].Close(East)
+	maze.row(1)[4] = maze.row(1)[4].Open(West)
+	maze.row(0)[0] = maze.row(0)[0].Open(South)
+	assert.EqualError(t, maze.Validate(), "maze: "+
+		"row 0, column 0: south wall opens out of maze; "+
+		"row 1, column 3: east wall does not match west wall of column 4; "+
+		"1 cells can not be reached from top row, first at row 1, column 4")
+}
+
+// TestValidateProperty grows & compacts mazes of every generator,
+// many seeds & openness, checking they stay valid all along.
+func TestValidateProperty(t *testing.T) {
+	for name, generator := range Generators {
+		property := func(seed int64, openness uint8, steps []uint8) bool {
+			maze := NewMaze(8, DefaultColumns, rand.New(rand.NewSource(seed)))
+			maze.SetGenerator(generator)
+			maze.SetOpenness(float64(openness) / 255)
+			maze.Populate()
+			for _, step := range steps {
+				if step%2 == 0 {
+					maze.GrowBy(int(step/2)%16 + 1)
+				} else {
+					maze.Compact(int(step/2) % maze.Rows())
+				}
+				if validateErr := maze.Validate(); validateErr != nil {
+					t.Logf("%s, seed %d, openness %d: %v", name, seed, openness, validateErr)
+					return false
+				}
+			}
+			return true
+		}
+		assert.Nil(t, quick.Check(property, &quick.Config{
+			MaxCount: 200,
+			Rand:     randSrc(),
+		}), name)
+	}
+}
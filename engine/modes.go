@@ -0,0 +1,164 @@
+package engine
+
+// GhostMode decides what a ghost is heading for.
+type GhostMode int
+
+const (
+	// ScatterMode ghosts retreat to their home corner.
+	ScatterMode GhostMode = iota
+	// ChaseMode ghosts follow their strategy to catch pacman.
+	ChaseMode
+	// FrightenedMode ghosts run away from pacman, slowly,
+	// and can be eaten.
+	FrightenedMode
+	// EyesMode ghosts have been eaten, they travel back to
+	// respawn point and are harmless until they get there.
+	EyesMode
+)
+
+const (
+	// FrightenedBlinkTicks is the time left on invincibility,
+	// from which frightened ghosts start blinking.
+	FrightenedBlinkTicks = 2 * TicksPerSecond
+)
+
+type modePhase struct {
+	mode  GhostMode
+	ticks int // zero lasts forever
+}
+
+// modeSchedules alternates scatter & chase phases for each
+// level, last schedule is used for all higher levels.
+// Durations follow the arcade, with chase getting longer
+// and scatter shorter as levels go up.
+var modeSchedules = [][]modePhase{
+	{ // level 1
+		{ScatterMode, 7 * TicksPerSecond},
+		{ChaseMode, 20 * TicksPerSecond},
+		{ScatterMode, 7 * TicksPerSecond},
+		{ChaseMode, 20 * TicksPerSecond},
+		{ScatterMode, 5 * TicksPerSecond},
+		{ChaseMode, 20 * TicksPerSecond},
+		{ScatterMode, 5 * TicksPerSecond},
+		{ChaseMode, 0},
+	},
+	{ // levels 2 to 4
+		{ScatterMode, 7 * TicksPerSecond},
+		{ChaseMode, 20 * TicksPerSecond},
+		{ScatterMode, 7 * TicksPerSecond},
+		{ChaseMode, 20 * TicksPerSecond},
+		{ScatterMode, 5 * TicksPerSecond},
+		{ChaseMode, 60 * TicksPerSecond},
+		{ScatterMode, 1},
+		{ChaseMode, 0},
+	},
+	{ // level 5 onwards
+		{ScatterMode, 5 * TicksPerSecond},
+		{ChaseMode, 20 * TicksPerSecond},
+		{ScatterMode, 5 * TicksPerSecond},
+		{ChaseMode, 20 * TicksPerSecond},
+		{ScatterMode, 5 * TicksPerSecond},
+		{ChaseMode, 60 * TicksPerSecond},
+		{ScatterMode, 1},
+		{ChaseMode, 0},
+	},
+}
+
+// ModeScheduler switches between scatter & chase
+// modes, following the schedule of current level.
+type ModeScheduler struct {
+	level int
+	phase int
+	timer Timer
+}
+
+// NewModeScheduler returns a scheduler at the
+// start of schedule for given level.
+func NewModeScheduler(level int) *ModeScheduler {
+	m := &ModeScheduler{}
+	m.SetLevel(level)
+	return m
+}
+
+// SetLevel restarts the schedule for given level.
+func (m *ModeScheduler) SetLevel(level int) {
+	m.level = level
+	m.phase = 0
+	m.timer.Start(m.schedule()[0].ticks)
+}
+
+// Mode returns mode of current phase.
+func (m *ModeScheduler) Mode() GhostMode {
+	return m.schedule()[m.phase].mode
+}
+
+// Tick advances the schedule by a tick & reports
+// whether it moved on to next phase.
+func (m *ModeScheduler) Tick() bool {
+	if !m.timer.Tick() {
+		return false
+	}
+	schedule := m.schedule()
+	if m.phase+1 < len(schedule) {
+		m.phase += 1
+		m.timer.Start(schedule[m.phase].ticks)
+	}
+	return true
+}
+
+func (m *ModeScheduler) schedule() []modePhase {
+	switch {
+	case m.level <= 1:
+		return modeSchedules[0]
+	case m.level <= 4:
+		return modeSchedules[1]
+	default:
+		return modeSchedules[2]
+	}
+}
+
+// scatterTarget returns home corner of given kind of ghost,
+// red & pink sit above in the maze, cyan & orange below.
+func scatterTarget(kind GhostType, columns, rows int) (int, int) {
+	switch kind {
+	case Ghost2:
+		return columns - 1, rows - 1
+	case Ghost3:
+		return 0, rows - 1
+	case Ghost4:
+		return columns - 1, 0
+	default:
+		return 0, 0
+	}
+}
+
+// respawnPoint returns the cell eaten ghosts return to,
+// if they can reach it, see Simulation.sendHome.
+func respawnPoint(columns, rows int) (int, int) {
+	return columns / 2, rows - 1
+}
+
+// sendHome turns ghost to eyes, heading for cell closest to
+// respawn point that it can reach without leaving the view.
+// Top row is often joined only through rows above the view,
+// respawn point itself may not be reachable.
+func (s *Simulation) sendHome(i int) {
+	ghost := s.data.Ghosts[i]
+	x, y := respawnPoint(s.data.Columns, len(s.data.Grid))
+	x, y = s.paths.Nearest(ghost.CellX, ghost.CellY-s.data.Row, x, y)
+	s.data.Ghosts[i].Mode = EyesMode
+	s.data.Ghosts[i].homeX, s.data.Ghosts[i].homeY = x, y+s.data.Row
+}
+
+// ghostSpeed returns pixels per tick, ghosts
+// in given mode move at current difficulty.
+func (s *Simulation) ghostSpeed(mode GhostMode) float64 {
+	switch mode {
+	case FrightenedMode:
+		return s.current.GhostSpeed * s.rules.FrightenedSlowdown
+	case EyesMode:
+		return s.rules.EyesSpeed
+	default:
+		return s.current.GhostSpeed
+	}
+}
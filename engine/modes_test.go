@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModeScheduler(t *testing.T) {
+	modes := NewModeScheduler(1)
+	assert.Equal(t, ScatterMode, modes.Mode(), "Should start in scatter")
+	for i := 1; i < 7*TicksPerSecond; i++ {
+		assert.False(t, modes.Tick(), "Should stay in phase")
+	}
+	assert.True(t, modes.Tick(), "Should switch phase")
+	assert.Equal(t, ChaseMode, modes.Mode(), "Should chase")
+
+	modes.SetLevel(5)
+	assert.Equal(t, ScatterMode, modes.Mode(), "Should restart in scatter")
+	changes := 0
+	for i := 0; i < 10*60*TicksPerSecond; i++ {
+		if modes.Tick() {
+			changes += 1
+		}
+	}
+	assert.Equal(t, 7, changes, "Should go through all phases")
+	assert.Equal(t, ChaseMode, modes.Mode(), "Should chase forever")
+}
+
+func TestFrightenedGhosts(t *testing.T) {
+	sim := NewSimulation(3, nil)
+	defer sim.Close()
+	data := sim.Data()
+	data.Powers[0] = NewPower(data.Pacman.CellX, data.Pacman.CellY, Invincibility)
+	sim.Step(Input{})
+	for _, ghost := range data.Ghosts {
+		assert.Equal(t, FrightenedMode, ghost.Mode, "Should be frightened")
+	}
+
+	data.Ghosts[0] = NewGhost(data.Pacman.CellX, data.Pacman.CellY, Ghost1, South)
+	data.Ghosts[0].Mode = FrightenedMode
+	data.Ghosts[0].PosX, data.Ghosts[0].PosY = data.Pacman.PosX, data.Pacman.PosY
+	score := data.Score
+	events := sim.Step(Input{})
+	assert.Contains(t, events, GhostEaten, "Should eat ghost")
+	assert.Equal(t, EyesMode, data.Ghosts[0].Mode, "Should turn to eyes")
+	assert.True(t, data.Score >= score+200, "Should get bonus")
+
+	for data.Invincible.Active() {
+		sim.Step(Input{})
+	}
+	for _, ghost := range data.Ghosts {
+		assert.NotEqual(t, FrightenedMode, ghost.Mode, "Should not be frightened")
+	}
+}
+
+func TestEyesReturn(t *testing.T) {
+	for _, seed := range []int64{0, 6, 7, 10} {
+		sim := NewSimulation(seed, nil)
+		defer sim.Close()
+		data := sim.Data()
+		data.Lifes = 1000
+		for i := range data.Ghosts {
+			sim.sendHome(i)
+		}
+		// eyes cross the whole view at most a few times over.
+		for tick := 0; tick < 40*TicksPerSecond; tick++ {
+			sim.Step(Input{})
+		}
+		for i, ghost := range data.Ghosts {
+			assert.NotEqual(t, EyesMode, ghost.Mode, "Ghost %d of seed %d should be home", i, seed)
+		}
+	}
+}
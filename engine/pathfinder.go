@@ -0,0 +1,184 @@
+package engine
+
+import "math"
+
+const (
+	// PathBudget is number of path searches
+	// a Pathfinder runs in a single tick.
+	PathBudget = 4
+
+	// maxCachedPaths bounds the cache, random targets
+	// of wandering ghosts would grow it otherwise.
+	maxCachedPaths = 64
+)
+
+// Pathfinder finds shortest paths through first rows of a maze,
+// by breadth first search. A search from a target cell gives
+// distance of every cell to it, which is cached until the maze
+// changes, so ghosts heading for same cell share the work.
+//
+// Number of searches is limited per tick, callers are expected
+// to fall back to a cheaper estimate once budget runs out.
+type Pathfinder struct {
+	maze    *Maze
+	rows    int
+	columns int
+	budget  int
+	spent   int
+	version int
+	cache   map[int][]int
+}
+
+// NewPathfinder returns a pathfinder over given number of
+// rows from the start of maze, running upto budget
+// searches per tick.
+func NewPathfinder(maze *Maze, rows, budget int) *Pathfinder {
+	return &Pathfinder{
+		maze:    maze,
+		rows:    rows,
+		columns: maze.Columns(),
+		budget:  budget,
+		version: maze.Version(),
+		cache:   make(map[int][]int),
+	}
+}
+
+// Tick starts a new tick, renewing the search budget.
+func (p *Pathfinder) Tick() {
+	p.spent = 0
+}
+
+// Distance returns number of steps on shortest path between
+// two cells. It returns false if path is not known, either
+// because cells are not connected or budget has run out.
+func (p *Pathfinder) Distance(fromX, fromY, toX, toY int) (int, bool) {
+	if !p.inside(fromX, fromY) {
+		return 0, false
+	}
+	distances, ok := p.Distances(toX, toY)
+	if !ok || distances[fromY*p.columns+fromX] < 0 {
+		return 0, false
+	}
+	return distances[fromY*p.columns+fromX], true
+}
+
+// Distances returns distance of every cell to given target,
+// indexed by row*columns+column, unreachable cells are -1.
+// Target outside the rows is moved to closest cell inside.
+func (p *Pathfinder) Distances(toX, toY int) ([]int, bool) {
+	if p.version != p.maze.Version() {
+		p.version = p.maze.Version()
+		p.cache = make(map[int][]int)
+	}
+
+	toX, toY = clamp(toX, 0, p.columns-1), clamp(toY, 0, p.rows-1)
+	if distances, ok := p.cache[toY*p.columns+toX]; ok {
+		return distances, true
+	}
+	if p.spent >= p.budget {
+		return nil, false
+	}
+	p.spent += 1
+
+	if len(p.cache) >= maxCachedPaths {
+		p.cache = make(map[int][]int)
+	}
+	distances := p.search(toX, toY)
+	p.cache[toY*p.columns+toX] = distances
+	return distances, true
+}
+
+// Nearest returns the cell connected to from, closest in a
+// straight line to given target. It searches even once budget
+// has run out, so it is meant for rare events.
+func (p *Pathfinder) Nearest(fromX, fromY, toX, toY int) (int, int) {
+	fromX, fromY = clamp(fromX, 0, p.columns-1), clamp(fromY, 0, p.rows-1)
+	distances, ok := p.Distances(fromX, fromY)
+	if !ok {
+		distances = p.search(fromX, fromY)
+	}
+
+	nearestX, nearestY, nearest := fromX, fromY, math.Inf(1)
+	for cell, distance := range distances {
+		if distance < 0 {
+			continue
+		}
+		x, y := cell%p.columns, cell/p.columns
+		if d := math.Hypot(float64(x-toX), float64(y-toY)); d < nearest {
+			nearestX, nearestY, nearest = x, y, d
+		}
+	}
+	return nearestX, nearestY
+}
+
+// search runs over rows maze already holds, rows past them are
+// walls. Getting them would grow the maze, drawing on its random
+// stream & changing maze for every later tick.
+func (p *Pathfinder) search(toX, toY int) []int {
+	held := p.maze.Rows()
+	if held > p.rows {
+		held = p.rows
+	}
+	grid := p.maze.Get(0, held)
+
+	distances := make([]int, p.rows*p.columns)
+	for i := range distances {
+		distances[i] = -1
+	}
+	if toY >= len(grid) {
+		return distances
+	}
+
+	queue := make([]int, 0, len(distances))
+	queue = append(queue, toY*p.columns+toX)
+	distances[toY*p.columns+toX] = 0
+
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		x, y := cell%p.columns, cell/p.columns
+		for dir := North; dir <= West; dir++ {
+			// walls are same on both sides, so a cell
+			// open towards us can be entered from us.
+			if grid[y][x].HasWall(dir) {
+				continue
+			}
+			nx, ny := neighbour(x, y, dir)
+			if !p.inside(nx, ny) || ny >= len(grid) || distances[ny*p.columns+nx] >= 0 {
+				continue
+			}
+			distances[ny*p.columns+nx] = distances[cell] + 1
+			queue = append(queue, ny*p.columns+nx)
+		}
+	}
+
+	return distances
+}
+
+func (p *Pathfinder) inside(x, y int) bool {
+	return x >= 0 && x < p.columns && y >= 0 && y < p.rows
+}
+
+// neighbour returns the cell next to given one, in given direction.
+func neighbour(x, y int, dir Direction) (int, int) {
+	switch dir {
+	case North:
+		return x, y + 1
+	case East:
+		return x + 1, y
+	case South:
+		return x, y - 1
+	default:
+		return x - 1, y
+	}
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathfinderDistance(t *testing.T) {
+	maze := NewPopulatedMaze(2, DefaultColumns, randSrc())
+	paths := NewPathfinder(maze, 2, PathBudget)
+
+	// see TestPopulate for the grid, cell (0, 0) opens only
+	// to east, into (1, 0) which opens to north.
+	dist, ok := paths.Distance(0, 0, 0, 0)
+	assert.True(t, ok, "Should find path")
+	assert.Equal(t, 0, dist, "Should be same cell")
+	dist, ok = paths.Distance(0, 0, 1, 1)
+	assert.True(t, ok, "Should find path")
+	assert.Equal(t, 2, dist, "Should go around the wall")
+	// (0, 1) is walled from (0, 0), path goes via (1, 1) & (1, 0).
+	dist, ok = paths.Distance(0, 1, 0, 0)
+	assert.True(t, ok, "Should find path")
+	assert.Equal(t, 3, dist, "Should go around the wall")
+}
+
+func TestPathfinderRowsHeld(t *testing.T) {
+	maze := NewPopulatedMaze(2, DefaultColumns, randSrc())
+	paths := NewPathfinder(maze, 4, PathBudget)
+	version := maze.Version()
+
+	dist, ok := paths.Distance(0, 1, 0, 0)
+	assert.True(t, ok, "Should find path")
+	assert.Equal(t, 3, dist, "Should go around the wall")
+	_, ok = paths.Distance(0, 0, 0, 3)
+	assert.False(t, ok, "Rows not held should be walls")
+	_, ok = paths.Distance(0, 3, 0, 0)
+	assert.False(t, ok, "Rows not held should be walls")
+	assert.Equal(t, 2, maze.Rows(), "Maze should not grow")
+	assert.Equal(t, version, maze.Version(), "Maze should not change")
+}
+
+func TestPathfinderBudget(t *testing.T) {
+	maze := NewPopulatedMaze(24, DefaultColumns, randSrc())
+	paths := NewPathfinder(maze, 24, 2)
+
+	_, ok := paths.Distances(0, 0)
+	assert.True(t, ok, "Should search")
+	_, ok = paths.Distances(1, 0)
+	assert.True(t, ok, "Should search")
+	_, ok = paths.Distances(2, 0)
+	assert.False(t, ok, "Should run out of budget")
+	_, ok = paths.Distances(0, 0)
+	assert.True(t, ok, "Should be cached")
+
+	paths.Tick()
+	_, ok = paths.Distances(2, 0)
+	assert.True(t, ok, "Should renew budget")
+}
+
+func TestPathfinderCache(t *testing.T) {
+	maze := NewPopulatedMaze(24, DefaultColumns, randSrc())
+	paths := NewPathfinder(maze, 24, 1)
+
+	before, _ := paths.Distances(5, 5)
+	paths.Tick()
+	cached, _ := paths.Distances(5, 5)
+	assert.Equal(t, before, cached, "Should be cached")
+
+	maze.Compact(4)
+	maze.GrowBy(4)
+	after, ok := paths.Distances(5, 5)
+	assert.True(t, ok, "Should search again")
+	assert.Equal(t, paths.search(5, 5), after, "Should match changed maze")
+	assert.NotEqual(t, before, after, "Should not be stale")
+}
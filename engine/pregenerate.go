@@ -0,0 +1,138 @@
+package engine
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// Pregenerate starts creating rows ahead of time, on a goroutine
+// of its own, so growing the maze does not cost a frame. Rows are
+// made in chunks of given size, upto ahead chunks are kept ready.
+// GrowBy & Get take ready rows, and never wait for worker, if it
+// fell behind next row is made right away, in its place.
+//
+// Worker owns rand source, generator & braiding of the maze from
+// now on, so they must not be changed after. Rows are made one
+// after other whichever side makes them, so they are same for a
+// seed. Openness of each row is given by its absolute index,
+// which keeps rows same however far ahead they are made. Rows
+// held are discarded, maze continues from an empty row.
+//
+// Close has to be called once maze is no longer used.
+func (m *Maze) Pregenerate(chunk, ahead int, openness func(row int) float64) {
+	m.Close()
+	m.Compact(m.rows)
+	m.worker = &rowWorker{
+		chunk:     chunk,
+		limit:     chunk * ahead,
+		row:       m.first,
+		columns:   m.columns,
+		generator: m.generator,
+		braiding:  m.braiding,
+		openness:  openness,
+		src:       m.rand,
+		wake:      make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+	}
+	go m.worker.run()
+}
+
+// Close stops worker started by Pregenerate, worker may
+// still be using rand source, so maze must not grow after.
+func (m *Maze) Close() {
+	if m.worker != nil {
+		close(m.worker.stop)
+		m.worker = nil
+	}
+}
+
+// rowWorker makes rows on its goroutine into ready, it
+// refills them a chunk at a time. Rows are made under lock, so either side can
+// make the next one, each lock is held for a single row.
+type rowWorker struct {
+	chunk int
+	limit int
+	wake  chan struct{}
+	stop  chan struct{}
+
+	mu    sync.Mutex
+	ready [][]Cell
+	// inline counts rows made by next, as worker fell behind.
+	inline int
+
+	row       int
+	columns   int
+	generator RowGenerator
+	braiding  Braiding
+	openness  func(row int) float64
+	src       *rand.Rand
+	previous  []Cell
+}
+
+// next returns the next row, making it right
+// away if worker has none ready.
+func (w *rowWorker) next() []Cell {
+	w.mu.Lock()
+	var row []Cell
+	if len(w.ready) > 0 {
+		row = w.ready[0]
+		w.ready = w.ready[1:]
+	} else {
+		row = w.make()
+		w.inline += 1
+	}
+	room := w.limit - len(w.ready)
+	w.mu.Unlock()
+
+	if room >= w.chunk {
+		select {
+		case w.wake <- struct{}{}:
+		default:
+		}
+	}
+	return row
+}
+
+// run makes rows while there is room for them, & sleeps
+// till next makes room for a whole chunk otherwise.
+func (w *rowWorker) run() {
+	for {
+		w.mu.Lock()
+		full := len(w.ready) >= w.limit
+		if !full {
+			w.ready = append(w.ready, w.make())
+		}
+		w.mu.Unlock()
+
+		if full {
+			select {
+			case <-w.wake:
+			case <-w.stop:
+				return
+			}
+		} else {
+			select {
+			case <-w.stop:
+				return
+			default:
+			}
+		}
+	}
+}
+
+// make returns the next row, a row is only finished once
+// row above it is made, as that opens its north walls &
+// braids it, same as Maze.populateRow. Lock must be held.
+func (w *rowWorker) make() []Cell {
+	for {
+		next := w.generator.NextRow(w.previous, w.columns, w.openness(w.row), w.src)
+		w.row += 1
+		previous := w.previous
+		w.previous = next
+		if previous != nil {
+			joinRows(previous, next)
+			braid(previous, next, w.braiding, w.src)
+			return previous
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// RulesVersion identifies the rules a replay was recorded with.
+// It has to be bumped whenever a change to simulation makes
+// same seed & inputs play out differently.
+const RulesVersion = 10
+
+// MaxReplayTicks bounds number of inputs of a decoded replay,
+// a day of play, so a corrupt file can not run out of memory.
+const MaxReplayTicks = 24 * 60 * 60 * TicksPerSecond
+
+var replayMagic = []byte("PACR")
+
+// Replay is a recording of a run, its seed & the input
+// passed to every Step. Playing the inputs on a simulation
+// with same seed, rules version & rules repeats the run exactly.
+type Replay struct {
+	Version int
+	// Rules is fingerprint of rules run was played with.
+	Rules  uint32
+	Seed   int64
+	Inputs []Input
+}
+
+// NewReplay returns an empty recording for a run
+// started with given seed & rules.
+func NewReplay(seed int64, rules *Rules) *Replay {
+	if rules == nil {
+		rules = DefaultRules()
+	}
+	return &Replay{
+		Version: RulesVersion,
+		Rules:   rules.Fingerprint(),
+		Seed:    seed,
+		Inputs:  make([]Input, 0),
+	}
+}
+
+// Record appends input of a single tick.
+func (r *Replay) Record(input Input) {
+	r.Inputs = append(r.Inputs, input)
+}
+
+// Play runs the recording on a new simulation and returns
+// it, in the state it was after last recorded tick. Rules
+// have to be same as the ones run was recorded with,
+// simulation has to be closed once done with.
+func (r *Replay) Play(rules *Rules) (*Simulation, error) {
+	if checkErr := r.Check(rules); checkErr != nil {
+		return nil, checkErr
+	}
+	sim := NewSimulation(r.Seed, rules)
+	for _, input := range r.Inputs {
+		sim.Step(input)
+	}
+	return sim, nil
+}
+
+// Check returns an error if run was recorded with
+// rules other than the given ones.
+func (r *Replay) Check(rules *Rules) error {
+	if rules == nil {
+		rules = DefaultRules()
+	}
+	if r.Rules != rules.Fingerprint() {
+		return fmt.Errorf("replay: recorded with rules %08x, playing with rules %08x",
+			r.Rules, rules.Fingerprint())
+	}
+	return nil
+}
+
+// MarshalBinary encodes the replay. Inputs are stored as
+// run lengths of 4 bit key states, since the keys change
+// only on a few ticks.
+func (r *Replay) MarshalBinary() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, 64))
+	scratch := make([]byte, binary.MaxVarintLen64)
+
+	putUvarint := func(v uint64) {
+		buf.Write(scratch[:binary.PutUvarint(scratch, v)])
+	}
+
+	buf.Write(replayMagic)
+	putUvarint(uint64(r.Version))
+	putUvarint(uint64(r.Rules))
+	buf.Write(scratch[:binary.PutVarint(scratch, r.Seed)])
+
+	runs := make([][2]uint64, 0)
+	for i := 0; i < len(r.Inputs); i++ {
+		keys := uint64(r.Inputs[i].keys())
+		if len(runs) > 0 && runs[len(runs)-1][0] == keys {
+			runs[len(runs)-1][1] += 1
+		} else {
+			runs = append(runs, [2]uint64{keys, 1})
+		}
+	}
+
+	putUvarint(uint64(len(runs)))
+	for _, run := range runs {
+		buf.WriteByte(byte(run[0]))
+		putUvarint(run[1])
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a replay encoded by MarshalBinary.
+// Replays recorded with different rules are rejected, as they
+// would not play out the same, and so are replays longer than
+// MaxReplayTicks or followed by trailing bytes.
+func (r *Replay) UnmarshalBinary(data []byte) error {
+	buf := bytes.NewReader(data)
+
+	magic := make([]byte, len(replayMagic))
+	if _, readErr := buf.Read(magic); readErr != nil || !bytes.Equal(magic, replayMagic) {
+		return errors.New("replay: not a replay file")
+	}
+
+	version, versionErr := binary.ReadUvarint(buf)
+	if versionErr != nil {
+		return fmt.Errorf("replay: reading version: %v", versionErr)
+	}
+	if version != RulesVersion {
+		return fmt.Errorf("replay: recorded with rules version %d, current version is %d",
+			version, RulesVersion)
+	}
+
+	rules, rulesErr := binary.ReadUvarint(buf)
+	if rulesErr != nil {
+		return fmt.Errorf("replay: reading rules: %v", rulesErr)
+	}
+
+	seed, seedErr := binary.ReadVarint(buf)
+	if seedErr != nil {
+		return fmt.Errorf("replay: reading seed: %v", seedErr)
+	}
+
+	numOfRuns, numOfRunsErr := binary.ReadUvarint(buf)
+	if numOfRunsErr != nil {
+		return fmt.Errorf("replay: reading inputs: %v", numOfRunsErr)
+	}
+
+	inputs := make([]Input, 0)
+	for i := uint64(0); i < numOfRuns; i++ {
+		keys, keysErr := buf.ReadByte()
+		if keysErr != nil {
+			return fmt.Errorf("replay: reading inputs: %v", keysErr)
+		}
+		length, lengthErr := binary.ReadUvarint(buf)
+		if lengthErr != nil {
+			return fmt.Errorf("replay: reading inputs: %v", lengthErr)
+		}
+		if length > uint64(MaxReplayTicks-len(inputs)) {
+			return fmt.Errorf("replay: more than %d ticks", MaxReplayTicks)
+		}
+		input := inputFromKeys(keys)
+		for j := uint64(0); j < length; j++ {
+			inputs = append(inputs, input)
+		}
+	}
+	if buf.Len() > 0 {
+		return fmt.Errorf("replay: %d trailing bytes", buf.Len())
+	}
+
+	r.Version = int(version)
+	r.Rules = uint32(rules)
+	r.Seed = seed
+	r.Inputs = inputs
+
+	return nil
+}
+
+const (
+	keyUp byte = 1 << iota
+	keyDown
+	keyLeft
+	keyRight
+)
+
+func (i Input) keys() byte {
+	keys := byte(0)
+	if i.Up {
+		keys |= keyUp
+	}
+	if i.Down {
+		keys |= keyDown
+	}
+	if i.Left {
+		keys |= keyLeft
+	}
+	if i.Right {
+		keys |= keyRight
+	}
+	return keys
+}
+
+func inputFromKeys(keys byte) Input {
+	return Input{
+		Up:    keys&keyUp != 0,
+		Down:  keys&keyDown != 0,
+		Left:  keys&keyLeft != 0,
+		Right: keys&keyRight != 0,
+	}
+}
@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplay(t *testing.T) {
+	src := randSrc()
+	sim := NewSimulation(11, nil)
+	defer sim.Close()
+	replay := NewReplay(11, nil)
+	for i := 0; i < 5000; i++ {
+		input := inputFromKeys(byte(src.Intn(16)))
+		if i%7 != 0 {
+			input = Input{}
+		}
+		replay.Record(input)
+		sim.Step(input)
+	}
+
+	data, marshalErr := replay.MarshalBinary()
+	assert.Nil(t, marshalErr, "Should be nil")
+
+	loaded := &Replay{}
+	assert.Nil(t, loaded.UnmarshalBinary(data), "Should be nil")
+	assert.Equal(t, replay, loaded, "Should be equal")
+	played, playErr := loaded.Play(nil)
+	assert.Nil(t, playErr, "Should be nil")
+	defer played.Close()
+	assert.Equal(t, sim.Data(), played.Data(), "Should be equal")
+
+	rules := DefaultRules()
+	rules.GhostBonus = 100
+	_, playErr = loaded.Play(rules)
+	assert.NotNil(t, playErr, "Should not be nil")
+}
+
+func TestReplayVersion(t *testing.T) {
+	replay := NewReplay(11, nil)
+	replay.Version = RulesVersion + 1
+	data, _ := replay.MarshalBinary()
+	assert.NotNil(t, (&Replay{}).UnmarshalBinary(data), "Should not be nil")
+	assert.NotNil(t, (&Replay{}).UnmarshalBinary([]byte("PAC")), "Should not be nil")
+}
+
+func TestReplayCorrupt(t *testing.T) {
+	data, _ := NewReplay(11, nil).MarshalBinary()
+	// header ends with zero runs, replace it with a run of keys.
+	header := data[:len(data)-1]
+	scratch := make([]byte, binary.MaxVarintLen64)
+	run := func(runs int, length uint64) []byte {
+		corrupt := append([]byte{}, header...)
+		corrupt = append(corrupt, scratch[:binary.PutUvarint(scratch, uint64(runs))]...)
+		for i := 0; i < runs; i++ {
+			corrupt = append(corrupt, keyUp)
+			corrupt = append(corrupt, scratch[:binary.PutUvarint(scratch, length)]...)
+		}
+		return corrupt
+	}
+
+	loaded := &Replay{}
+	assert.Nil(t, loaded.UnmarshalBinary(run(1, MaxReplayTicks)), "Should load longest replay")
+	assert.Len(t, loaded.Inputs, MaxReplayTicks, "Should hold every tick")
+
+	assert.EqualError(t, (&Replay{}).UnmarshalBinary(run(1, MaxReplayTicks+1)),
+		fmt.Sprintf("replay: more than %d ticks", MaxReplayTicks))
+	assert.EqualError(t, (&Replay{}).UnmarshalBinary(run(2, MaxReplayTicks/2+1)),
+		fmt.Sprintf("replay: more than %d ticks", MaxReplayTicks))
+	assert.EqualError(t, (&Replay{}).UnmarshalBinary(run(1, math.MaxUint64)),
+		fmt.Sprintf("replay: more than %d ticks", MaxReplayTicks))
+	assert.EqualError(t, (&Replay{}).UnmarshalBinary(append(run(1, 10), 0, 0)),
+		"replay: 2 trailing bytes")
+	truncated := run(1, 10)
+	assert.NotNil(t, (&Replay{}).UnmarshalBinary(truncated[:len(truncated)-1]), "Should not be nil")
+}
@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+)
+
+// Rules holds values which tune the game. Defaults give the
+// classic game, a rules file can override any of them.
+type Rules struct {
+	StartLifes int `json:"startLifes"`
+	MaxLifes   int `json:"maxLifes"`
+	DotScore   int `json:"dotScore"`
+	GhostBonus int `json:"ghostBonus"`
+
+	// PacmanSpeed in pixels per tick, it has to divide cell size
+	// evenly, so that pacman stops at centre of cells to turn.
+	PacmanSpeed float64 `json:"pacmanSpeed"`
+	// EyesSpeed in pixels per tick, for eaten ghosts.
+	EyesSpeed float64 `json:"eyesSpeed"`
+	// FrightenedSlowdown scales speed of frightened ghosts.
+	FrightenedSlowdown float64 `json:"frightenedSlowdown"`
+
+	// BodyRadius is half the size of pacman & ghosts, used
+	// to keep them off the walls, in pixels.
+	BodyRadius float64 `json:"bodyRadius"`
+	// PickupRadius is how close pacman gets to centre of
+	// a cell, to eat its dot or power, in pixels.
+	PickupRadius float64 `json:"pickupRadius"`
+	// GhostRadius is how close pacman & a ghost get,
+	// before they collide, in pixels.
+	GhostRadius float64 `json:"ghostRadius"`
+
+	// GhostRows spaces out ghosts at start of a run,
+	// one in every so many rows.
+	GhostRows int `json:"ghostRows"`
+	// LevelRows is number of rows to climb for next level.
+	LevelRows int `json:"levelRows"`
+
+	// Columns is width of the maze, in cells.
+	Columns int `json:"columns"`
+	// Generator names the algorithm creating the maze,
+	// one of the keys of Generators.
+	Generator string   `json:"generator"`
+	Braiding  Braiding `json:"braiding"`
+
+	Difficulty DifficultyTable `json:"difficulty"`
+}
+
+// DefaultRules returns rules of the classic game. Difficulty
+// is a copy of DefaultDifficulty, so it can be changed freely.
+func DefaultRules() *Rules {
+	return &Rules{
+		StartLifes:         5,
+		MaxLifes:           7,
+		DotScore:           1,
+		GhostBonus:         200,
+		PacmanSpeed:        2.0,
+		EyesSpeed:          2.0,
+		FrightenedSlowdown: 0.5,
+		BodyRadius:         20,
+		PickupRadius:       20,
+		GhostRadius:        30,
+		GhostRows:          2,
+		LevelRows:          48,
+		Columns:            DefaultColumns,
+		Generator:          "eller",
+		Braiding:           NoBraiding,
+		Difficulty:         append(DifficultyTable(nil), DefaultDifficulty...),
+	}
+}
+
+// LoadRules reads rules encoded as JSON, values missing
+// from it are kept as in DefaultRules. Difficulty is kept or
+// replaced as a whole, every step of it has to be complete.
+// Unknown keys & invalid values are rejected.
+func LoadRules(r io.Reader) (*Rules, error) {
+	rules := DefaultRules()
+	// json would merge steps into default ones, by index.
+	rules.Difficulty = nil
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if decodeErr := decoder.Decode(rules); decodeErr != nil {
+		return nil, fmt.Errorf("rules: %v", decodeErr)
+	}
+	if rules.Difficulty == nil {
+		rules.Difficulty = DefaultRules().Difficulty
+	}
+	if validateErr := rules.Validate(); validateErr != nil {
+		return nil, validateErr
+	}
+	return rules, nil
+}
+
+// Validate checks that every value is in a range
+// the game can be played with.
+func (r *Rules) Validate() error {
+	if r.MaxLifes < 1 {
+		return fmt.Errorf("rules: maxLifes must be at least 1, got %d", r.MaxLifes)
+	}
+	if r.StartLifes < 1 || r.StartLifes > r.MaxLifes {
+		return fmt.Errorf("rules: startLifes must be in [1, maxLifes = %d], got %d",
+			r.MaxLifes, r.StartLifes)
+	}
+	if r.DotScore < 0 {
+		return fmt.Errorf("rules: dotScore can not be negative, got %d", r.DotScore)
+	}
+	if r.GhostBonus < 0 {
+		return fmt.Errorf("rules: ghostBonus can not be negative, got %d", r.GhostBonus)
+	}
+	if r.PacmanSpeed <= 0 || r.PacmanSpeed > CellSize/4 ||
+		math.Mod(CellSize, r.PacmanSpeed) != 0 {
+		return fmt.Errorf("rules: pacmanSpeed must divide cell size %d evenly & be at most %d, got %v",
+			CellSize, CellSize/4, r.PacmanSpeed)
+	}
+	if r.EyesSpeed <= 0 || r.EyesSpeed > CellSize/4 {
+		return fmt.Errorf("rules: eyesSpeed must be in (0, %d], got %v", CellSize/4, r.EyesSpeed)
+	}
+	if r.FrightenedSlowdown <= 0 || r.FrightenedSlowdown > 1 {
+		return fmt.Errorf("rules: frightenedSlowdown must be in (0, 1], got %v", r.FrightenedSlowdown)
+	}
+	// walls are 12 pixels thick, on each side of a cell.
+	if r.BodyRadius <= 0 || r.BodyRadius > CellSize/2-12 {
+		return fmt.Errorf("rules: bodyRadius must be in (0, %d], got %v", CellSize/2-12, r.BodyRadius)
+	}
+	if r.PickupRadius <= 0 || r.PickupRadius > CellSize/2 {
+		return fmt.Errorf("rules: pickupRadius must be in (0, %d], got %v", CellSize/2, r.PickupRadius)
+	}
+	if r.GhostRadius <= 0 || r.GhostRadius > CellSize {
+		return fmt.Errorf("rules: ghostRadius must be in (0, %d], got %v", CellSize, r.GhostRadius)
+	}
+	if r.GhostRows < 1 {
+		return fmt.Errorf("rules: ghostRows must be at least 1, got %d", r.GhostRows)
+	}
+	if r.LevelRows < 4 {
+		return fmt.Errorf("rules: levelRows must be at least 4, got %d", r.LevelRows)
+	}
+	if r.Columns < MinColumns || r.Columns > MaxColumns {
+		return fmt.Errorf("rules: columns must be in [%d, %d], got %d", MinColumns, MaxColumns, r.Columns)
+	}
+	if _, ok := Generators[r.Generator]; !ok {
+		return fmt.Errorf("rules: generator must be one of eller, sidewinder & binarytree, got %q", r.Generator)
+	}
+	if r.Braiding.Loops < 0 || r.Braiding.Loops > 1 {
+		return fmt.Errorf("rules: braiding loops must be in [0, 1], got %v", r.Braiding.Loops)
+	}
+	if r.Braiding.DeadEnds < 0 || r.Braiding.DeadEnds > 1 {
+		return fmt.Errorf("rules: braiding deadEnds must be in [0, 1], got %v", r.Braiding.DeadEnds)
+	}
+	return r.Difficulty.Validate()
+}
+
+// Fingerprint returns a checksum of rules, runs played with
+// rules of different fingerprints do not replay the same.
+func (r *Rules) Fingerprint() uint32 {
+	buf := &bytes.Buffer{}
+	// encoding a struct of numbers can not fail.
+	json.NewEncoder(buf).Encode(r)
+	return crc32.ChecksumIEEE(buf.Bytes())
+}
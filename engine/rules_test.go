@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadRules(t *testing.T) {
+	rules, loadErr := LoadRules(strings.NewReader(`{
+		"startLifes": 3,
+		"ghostBonus": 500,
+		"difficulty": [
+			{"rows": 0, "ghostSpeed": 1, "ghosts": 4, "frightenedSeconds": 10, "powerRows": 4, "openness": 0.7},
+			{"rows": 40, "ghostSpeed": 1.5, "ghosts": 8, "frightenedSeconds": 5, "powerRows": 6, "openness": 0.5}
+		]
+	}`))
+	assert.Nil(t, loadErr, "Should be nil")
+	assert.Equal(t, 3, rules.StartLifes, "Lifes should be same")
+	assert.Equal(t, 500, rules.GhostBonus, "Bonus should be same")
+	assert.Equal(t, DefaultRules().MaxLifes, rules.MaxLifes, "Should keep default")
+	assert.Equal(t, 6, rules.Difficulty.At(20).Ghosts, "Should interpolate")
+	sim := NewSimulation(1, rules)
+	defer sim.Close()
+	assert.Equal(t, 3, sim.Data().Lifes, "Lifes should be same")
+}
+
+func TestLoadRulesInvalid(t *testing.T) {
+	cases := map[string]string{
+		`{"startLifes": 9}`:             "rules: startLifes must be in [1, maxLifes = 7], got 9",
+		`{"pacmanSpeed": 3}`:            "rules: pacmanSpeed must divide cell size 64 evenly & be at most 16, got 3",
+		`{"bodyRadius": 24}`:            "rules: bodyRadius must be in (0, 20], got 24",
+		`{"ghostRows": 0}`:              "rules: ghostRows must be at least 1, got 0",
+		`{"ghostBonus": -1}`:            "rules: ghostBonus can not be negative, got -1",
+		`{"generator": "prim"}`:         `rules: generator must be one of eller, sidewinder & binarytree, got "prim"`,
+		`{"braiding": {"deadEnds": 2}}`: "rules: braiding deadEnds must be in [0, 1], got 2",
+		`{"columns": 40}`:               "rules: columns must be in [6, 20], got 40",
+		`{"startLife": 3}`:              `rules: json: unknown field "startLife"`,
+		`{"difficulty": []}`:            "difficulty: table has no steps",
+		`{"difficulty": [{"rows": 10, "ghostSpeed": 1, "ghosts": 4, "frightenedSeconds": 5, "powerRows": 4, "openness": 0.5}]}`:            "difficulty: first step must be at 0 rows, got 10",
+		`{"difficulty": [{"rows": 0, "ghostSpeed": 1, "ghosts": 4, "frightenedSeconds": 5, "powerRows": 0, "openness": 0.5}]}`:             "difficulty: step 0: powerRows must be at least 1, got 0",
+		`{"difficulty": [{"rows": 0, "ghostSpeed": 1, "powerRows": 4}]}`:                                                                   "rules: difficulty step is missing ghosts",
+		`{"difficulty": [{"rows": 0, "ghostSpeed": 1, "ghosts": 4, "frightenedSeconds": 5, "powerRows": 4, "openness": 0.5, "speed": 2}]}`: `rules: json: unknown field "speed"`,
+	}
+	for rules, message := range cases {
+		_, loadErr := LoadRules(strings.NewReader(rules))
+		assert.EqualError(t, loadErr, message, rules)
+	}
+}
+
+func TestRulesFingerprint(t *testing.T) {
+	rules := DefaultRules()
+	assert.Equal(t, DefaultRules().Fingerprint(), rules.Fingerprint(), "Should be equal")
+	rules.DotScore = 2
+	assert.NotEqual(t, DefaultRules().Fingerprint(), rules.Fingerprint(), "Should not be equal")
+}
+
+func TestLoadRulesDifficulty(t *testing.T) {
+	rules, loadErr := LoadRules(strings.NewReader(`{
+		"difficulty": [
+			{"rows": 0, "ghostSpeed": 0.5, "ghosts": 0, "frightenedSeconds": 0, "powerRows": 9, "openness": 0}
+		]
+	}`))
+	assert.Nil(t, loadErr, "Should be nil")
+	assert.Equal(t, DifficultyTable{
+		{Rows: 0, GhostSpeed: 0.5, Ghosts: 0, FrightenedSeconds: 0, PowerRows: 9, Openness: 0},
+	}, rules.Difficulty, "Should not inherit default steps")
+
+	rules, loadErr = LoadRules(strings.NewReader(`{"dotScore": 2}`))
+	assert.Nil(t, loadErr, "Should be nil")
+	assert.Equal(t, DefaultDifficulty, rules.Difficulty, "Should keep default table")
+}
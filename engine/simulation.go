@@ -0,0 +1,771 @@
+// Package engine implements the rules of pacman, independent
+// of any rendering, input or audio library. It can be stepped
+// tick by tick, without a display, which makes it usable from
+// tests and tools.
+package engine
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+const (
+	CellSize     = 64
+	MazeViewSize = 1536
+
+	OffsetY = CellSize * 10
+)
+
+// Input is the state of direction controls for a single tick.
+type Input struct {
+	Up, Down, Left, Right bool
+}
+
+// Event is emitted by Step, to let front ends react to
+// things happening in the game (play sounds, switch screens).
+type Event int
+
+const (
+	DotEaten Event = iota
+	LifeGained
+	InvincibilityGained
+	GhostEaten
+	LifeLost
+	InvincibilityEnded
+	GameOver
+)
+
+// Simulation owns the maze, game data & random sources
+// of a run, and advances them one tick at a time.
+type Simulation struct {
+	seed      int64
+	rand      *rand.Rand
+	mazeRand  *rand.Rand
+	maze      *Maze
+	data      *Data
+	direction Direction
+	modes     *ModeScheduler
+	paths     *Pathfinder
+	// explored holds eaten dots of rows on screen, as a ring
+	// indexed by absolute row of maze, Data.Active is a view
+	// of it, like Data.Grid is of the maze.
+	explored [][]bool
+
+	rules *Rules
+	// current is difficulty at depth of the run.
+	current DifficultyStep
+
+	strategies map[GhostType]GhostStrategy
+}
+
+// NewSimulation returns a simulation with a new run
+// already set up. Seed decides every random choice
+// made during the run, so same seed gives same run
+// for same inputs. Game is played by given rules, nil
+// uses DefaultRules.
+//
+// Maze is generated from its own random stream, which
+// keeps the maze for a seed same, however the ghosts move.
+func NewSimulation(seed int64, rules *Rules) *Simulation {
+	if rules == nil {
+		rules = DefaultRules()
+	}
+	split := rand.New(rand.NewSource(seed))
+	s := &Simulation{
+		seed:     seed,
+		mazeRand: rand.New(rand.NewSource(split.Int63())),
+		rand:     rand.New(rand.NewSource(split.Int63())),
+
+		rules: rules,
+
+		strategies: make(map[GhostType]GhostStrategy),
+	}
+	for kind, strategy := range defaultGhostStrategies {
+		s.strategies[kind] = strategy
+	}
+	s.reset()
+	return s
+}
+
+// Seed returns the seed run was started with.
+func (s *Simulation) Seed() int64 {
+	return s.seed
+}
+
+// Close stops maze generation running in
+// background, run can not be stepped after.
+func (s *Simulation) Close() {
+	s.maze.Close()
+}
+
+// SetGhostStrategy replaces the strategy ghosts
+// of given kind use to chase pacman.
+func (s *Simulation) SetGhostStrategy(kind GhostType, strategy GhostStrategy) {
+	s.strategies[kind] = strategy
+}
+
+// SetRules tunes the run in progress with given rules, so
+// values can be tried out while playing. Maze keeps being made
+// as the run started, hence columns can not change. Replay of
+// a run whose rules changed can not be played back.
+func (s *Simulation) SetRules(rules *Rules) error {
+	if rules.Columns != s.rules.Columns {
+		return fmt.Errorf("rules: columns can not change during a run, got %d, want %d",
+			rules.Columns, s.rules.Columns)
+	}
+	s.rules = rules
+	s.current = rules.Difficulty.At(s.data.Depth())
+	// pacman turns only at centre of a cell, keep it where
+	// new speed gets it to centre, moving towards the centre.
+	speed := rules.PacmanSpeed
+	centreX := float64((CellSize * s.data.Pacman.CellX) + (CellSize / 2))
+	centreY := float64((CellSize * s.data.Pacman.CellY) + (CellSize / 2))
+	s.data.Pacman.PosX = centreX + math.Trunc((s.data.Pacman.PosX-centreX)/speed)*speed
+	s.data.Pacman.PosY = centreY + math.Trunc((s.data.Pacman.PosY-centreY)/speed)*speed
+	return nil
+}
+
+// Data returns the state of current run.
+func (s *Simulation) Data() *Data {
+	return s.data
+}
+
+// reset discards current run and sets up a new one.
+func (s *Simulation) reset() {
+	xcol := s.rand.Intn(s.rules.Columns)
+	numOfRows := MazeViewSize / CellSize
+	s.data = NewData(s.rules)
+	s.data.Seed = s.seed
+	s.modes = NewModeScheduler(s.data.Level)
+	s.data.Mode = s.modes.Mode()
+	s.current = s.rules.Difficulty.At(s.data.Depth())
+	s.maze = NewMaze(0, s.data.Columns, s.mazeRand)
+	// maze holds rows in view, upto 4 above them before being
+	// compacted & 16 more it grows by, ring never grows after.
+	s.maze.SetCapacity(numOfRows + 4 + 16)
+	s.maze.SetGenerator(Generators[s.rules.Generator])
+	s.maze.SetBraiding(s.rules.Braiding)
+	// rows are made ahead of time, with openness
+	// of difficulty at which they come into view.
+	difficulty := s.rules.Difficulty
+	s.maze.Pregenerate(16, 4, func(row int) float64 {
+		if row < numOfRows {
+			row = numOfRows
+		}
+		return difficulty.At(row - numOfRows).Openness
+	})
+	s.maze.GrowBy(32)
+	s.paths = NewPathfinder(s.maze, numOfRows, PathBudget)
+	s.explored = make([][]bool, numOfRows, numOfRows)
+	for i := 0; i < numOfRows; i++ {
+		s.explored[i] = make([]bool, s.data.Columns)
+	}
+	s.data.Active = make([][]bool, numOfRows, numOfRows)
+	s.data.RowVersions = make([]int, numOfRows, numOfRows)
+	s.view()
+	s.data.Pacman = Pacman{
+		Position{
+			CellX:     xcol,
+			CellY:     0,
+			PosX:      float64((xcol * CellSize) + (CellSize / 2)),
+			PosY:      CellSize / 2,
+			Direction: North,
+		},
+	}
+	s.direction = s.data.Pacman.Direction
+	s.data.Active[0][xcol] = true
+
+	powers := make([]Power, 0)
+	for i := 0; i < numOfRows; i += s.current.PowerRows {
+		cellX := s.rand.Intn(s.data.Columns)
+		cellY := s.rand.Intn(4) + i
+		kind := Invincibility
+		if (cellY-i)%2 == 0 {
+			kind = Life
+		}
+		powers = append(powers, NewPower(cellX, cellY, kind))
+	}
+	s.data.Powers = powers
+
+	ghosts := make([]Ghost, 0)
+	for i := 0; i < numOfRows; i += s.rules.GhostRows {
+		cellX := s.rand.Intn(s.data.Columns/2) + s.data.Columns/2
+		if i%(2*s.rules.GhostRows) == 0 {
+			cellX = s.rand.Intn(s.data.Columns / 2)
+		}
+		cellY := s.rand.Intn(s.rules.GhostRows) + i
+		if cellY >= numOfRows {
+			cellY = numOfRows - 1
+		}
+		kind := Ghost1
+		if (cellY-i)%4 == 0 {
+			kind = Ghost4
+		} else if (cellY-i)%3 == 0 {
+			kind = Ghost3
+		} else if (cellY-i)%2 == 0 {
+			kind = Ghost2
+		}
+		ghosts = append(ghosts, s.newGhost(cellX, cellY, kind, getExit(
+			s.data.Cell(cellX, cellY))))
+	}
+	if len(ghosts) > s.current.Ghosts {
+		ghosts = ghosts[:s.current.Ghosts]
+	}
+	s.data.Ghosts = ghosts
+
+	s.fitPowers()
+	s.fitGhosts()
+}
+
+// Step advances the run by a single tick, using the given
+// input to steer pacman. It returns events which happened
+// during the tick, in order of occurrence.
+func (s *Simulation) Step(input Input) []Event {
+	events := make([]Event, 0)
+
+	if s.data.Lifes < 1 {
+		return append(events, GameOver)
+	}
+
+	s.paths.Tick()
+
+	if s.data.Invincible.Tick() {
+		for i := 0; i < len(s.data.Ghosts); i++ {
+			if s.data.Ghosts[i].Mode == FrightenedMode {
+				s.data.Ghosts[i].Mode = s.data.Mode
+			}
+		}
+		events = append(events, InvincibilityEnded)
+	}
+	// schedule is on hold, while ghosts are frightened.
+	if !s.data.Invincible.Active() && s.modes.Tick() {
+		s.switchMode(s.modes.Mode())
+	}
+
+	numOfRows := MazeViewSize / CellSize
+	if s.data.Pacman.CellY-s.data.Row == len(s.data.Grid)-8 {
+		s.maze.Compact(4)
+		if (s.maze.Rows() - numOfRows) < 4 {
+			s.maze.GrowBy(16)
+		}
+		// rows scrolled off make room for rows
+		// coming on top, with all dots uneaten.
+		for i := numOfRows - 4; i < numOfRows; i++ {
+			row := s.explored[(s.maze.First()+i)%numOfRows]
+			for j := range row {
+				row[j] = false
+			}
+		}
+		s.view()
+
+		// powers & ghosts scrolling off are brought back on top,
+		// unless difficulty asks for fewer of them.
+		powers := s.data.Powers[:0]
+		for i := 0; i < len(s.data.Powers); i++ {
+			if s.data.Powers[i].CellY < s.data.Row {
+				if len(s.data.Powers)-i+len(powers) > s.numOfPowers() {
+					continue
+				}
+				cellX := s.rand.Intn(s.data.Columns)
+				cellY := s.rand.Intn(4) + (s.data.Row + numOfRows - 4)
+				s.data.Powers[i] = NewPower(cellX, cellY, s.data.Powers[i].Kind)
+			}
+			powers = append(powers, s.data.Powers[i])
+		}
+		s.data.Powers = powers
+		ghosts := s.data.Ghosts[:0]
+		for i := 0; i < len(s.data.Ghosts); i++ {
+			if s.data.Ghosts[i].CellY < s.data.Row {
+				if len(s.data.Ghosts)-i+len(ghosts) > s.current.Ghosts {
+					continue
+				}
+				cellX := s.rand.Intn(s.data.Columns)
+				cellY := s.rand.Intn(4) + (s.data.Row + numOfRows - 4)
+				s.data.Ghosts[i] = s.newGhost(
+					cellX, cellY,
+					s.data.Ghosts[i].Kind,
+					getExit(s.data.Cell(cellX, cellY)))
+			}
+			ghosts = append(ghosts, s.data.Ghosts[i])
+		}
+		s.data.Ghosts = ghosts
+
+		s.fitPowers()
+		s.fitGhosts()
+	}
+
+	s.steer(input)
+	s.movePacman()
+
+	if !s.data.Explored(s.data.Pacman.CellX, s.data.Pacman.CellY) {
+		if math.Abs(float64(
+			(s.data.Pacman.CellX*CellSize)+(CellSize/2),
+		)-(s.data.Pacman.PosX)) < s.rules.PickupRadius &&
+			math.Abs(float64(
+				(s.data.Pacman.CellY*CellSize)+(CellSize/2),
+			)-s.data.Pacman.PosY) < s.rules.PickupRadius {
+			s.data.Active[s.data.Pacman.CellY-s.data.Row][s.data.Pacman.CellX] = true
+			s.data.Score += s.rules.DotScore
+			events = append(events, DotEaten)
+		}
+	}
+
+	// check powers
+	for i := 0; i < len(s.data.Powers); i++ {
+		cellX := s.rand.Intn(s.data.Columns)
+		cellY := s.rand.Intn(4) +
+			(((s.data.Powers[i].CellY / 4) * 4) + numOfRows)
+		if s.pacmanTouchesPower(i) {
+			switch s.data.Powers[i].Kind {
+			case Life:
+				if s.data.Lifes < s.rules.MaxLifes {
+					s.data.Lifes += 1
+					s.data.Powers[i] = NewPower(cellX, cellY, s.data.Powers[i].Kind)
+					events = append(events, LifeGained)
+				}
+			case Invincibility:
+				s.data.Invincible.Start(int(s.current.FrightenedSeconds * TicksPerSecond))
+				for j := 0; j < len(s.data.Ghosts); j++ {
+					if s.data.Ghosts[j].Mode != FrightenedMode &&
+						s.data.Ghosts[j].Mode != EyesMode {
+						s.data.Ghosts[j].Mode = FrightenedMode
+						s.data.Ghosts[j].Direction = getOppositeDirection(s.data.Ghosts[j].Direction)
+					}
+				}
+				s.data.Powers[i] = NewPower(cellX, cellY, s.data.Powers[i].Kind)
+				events = append(events, InvincibilityGained)
+			}
+		}
+	}
+	// check ghosts
+	for i := 0; i < len(s.data.Ghosts); i++ {
+		if s.data.Ghosts[i].Mode != EyesMode && s.pacmanTouchesGhost(i) {
+			if s.data.Ghosts[i].Mode == FrightenedMode {
+				s.data.Score += s.rules.GhostBonus
+				s.sendHome(i)
+				events = append(events, GhostEaten)
+			} else {
+				s.data.Lifes -= 1
+				events = append(events, LifeLost)
+				cellX := s.rand.Intn(s.data.Columns)
+				cellY := s.rand.Intn(4) +
+					(((s.data.Ghosts[i].CellY / 4) * 4) + numOfRows)
+				s.data.Ghosts[i] = s.newGhost(
+					cellX, cellY, s.data.Ghosts[i].Kind, North)
+			}
+		}
+		s.moveGhost(i)
+	}
+
+	if s.data.Lifes < 1 {
+		events = append(events, GameOver)
+	}
+
+	return events
+}
+
+// numOfPowers returns number of powers in play
+// for current difficulty.
+func (s *Simulation) numOfPowers() int {
+	return (MazeViewSize / CellSize) / s.current.PowerRows
+}
+
+// fitPowers adds powers above the grid, till there
+// are as many as current difficulty asks for.
+func (s *Simulation) fitPowers() {
+	numOfRows := MazeViewSize / CellSize
+	for len(s.data.Powers) < s.numOfPowers() {
+		kind := Life
+		if len(s.data.Powers)%2 == 1 {
+			kind = Invincibility
+		}
+		cellX := s.rand.Intn(s.data.Columns)
+		cellY := s.rand.Intn(4) + (s.data.Row + numOfRows)
+		s.data.Powers = append(s.data.Powers, NewPower(cellX, cellY, kind))
+	}
+}
+
+// fitGhosts adds ghosts in top rows of the grid, till
+// there are as many as current difficulty asks for.
+func (s *Simulation) fitGhosts() {
+	numOfRows := MazeViewSize / CellSize
+	for len(s.data.Ghosts) < s.current.Ghosts {
+		kind := GhostType(len(s.data.Ghosts) % 4)
+		cellX := s.rand.Intn(s.data.Columns)
+		cellY := s.rand.Intn(4) + (s.data.Row + numOfRows - 4)
+		s.data.Ghosts = append(s.data.Ghosts, s.newGhost(
+			cellX, cellY, kind, getExit(s.data.Cell(cellX, cellY))))
+	}
+}
+
+// newGhost returns a ghost in current scheduled mode.
+func (s *Simulation) newGhost(x, y int, kind GhostType, dir Direction) Ghost {
+	ghost := NewGhost(x, y, kind, dir)
+	ghost.Mode = s.data.Mode
+	return ghost
+}
+
+// switchMode moves ghosts following the schedule to given mode,
+// like in arcade they turn around to signal the change.
+func (s *Simulation) switchMode(mode GhostMode) {
+	if s.data.Mode == mode {
+		return
+	}
+	s.data.Mode = mode
+	for i := 0; i < len(s.data.Ghosts); i++ {
+		if s.data.Ghosts[i].Mode == ScatterMode || s.data.Ghosts[i].Mode == ChaseMode {
+			s.data.Ghosts[i].Mode = mode
+			s.data.Ghosts[i].Direction = getOppositeDirection(s.data.Ghosts[i].Direction)
+		}
+	}
+}
+
+// view points rows of grid & active at rows of
+// maze & explored now on screen, along with
+// versions of rows.
+func (s *Simulation) view() {
+	s.data.Row = s.maze.First()
+	s.data.Grid = s.maze.Get(0, len(s.data.Active))
+	for i := range s.data.Active {
+		s.data.Active[i] = s.explored[(s.data.Row+i)%len(s.explored)]
+		s.data.RowVersions[i] = s.maze.RowVersion(i)
+	}
+}
+
+// deepen records pacman reaching given row, higher than it has
+// been. Level & difficulty follow depth, as shown on the HUD.
+func (s *Simulation) deepen(row int) {
+	s.data.depth = row
+	if level := 1 + row/s.rules.LevelRows; level != s.data.Level {
+		s.data.Level = level
+		s.modes.SetLevel(level)
+		s.switchMode(s.modes.Mode())
+	}
+	s.current = s.rules.Difficulty.At(row)
+}
+
+func (s *Simulation) steer(input Input) {
+	cell := s.data.Cell(s.data.Pacman.CellX, s.data.Pacman.CellY)
+	if input.Up {
+		if !cell.HasWall(North) {
+			s.direction = North
+		}
+	}
+	if input.Down {
+		if !cell.HasWall(South) {
+			s.direction = South
+		}
+	}
+	if input.Left {
+		if !cell.HasWall(West) {
+			s.direction = West
+		}
+	}
+	if input.Right {
+		if !cell.HasWall(East) {
+			s.direction = East
+		}
+	}
+}
+
+func (s *Simulation) movePacman() {
+	speed := s.rules.PacmanSpeed
+	radius := s.rules.BodyRadius
+	xcell := s.data.Pacman.CellX
+	ycell := s.data.Pacman.CellY
+
+	switch s.direction {
+	case North, South:
+		if s.data.Pacman.PosX == float64((CellSize*xcell)+(CellSize/2)) {
+			s.data.Pacman.Direction = s.direction
+		}
+	case East, West:
+		if s.data.Pacman.PosY == float64((CellSize*ycell)+(CellSize/2)) {
+			s.data.Pacman.Direction = s.direction
+		}
+	}
+
+	switch s.data.Pacman.Direction {
+	case North:
+		if canMove(
+			radius,
+			s.data.Pacman.PosX,
+			s.data.Pacman.PosY+speed,
+			s.data.Pacman.CellX,
+			s.data.Pacman.CellY,
+			s.data.Cell(xcell, ycell),
+		) {
+			// view follows pacman, once it is high enough.
+			if s.data.Pacman.PosY-s.data.ViewY > OffsetY {
+				s.data.ViewY += speed
+			}
+			s.data.Pacman.PosY += speed
+			if s.data.Pacman.PosY+radius > float64((ycell*CellSize)+CellSize) {
+				s.data.Pacman.CellY += 1
+				if s.data.Pacman.CellY > s.data.depth {
+					s.deepen(s.data.Pacman.CellY)
+				}
+			}
+		}
+	case South:
+		if canMove(
+			radius,
+			s.data.Pacman.PosX,
+			s.data.Pacman.PosY-speed,
+			s.data.Pacman.CellX,
+			s.data.Pacman.CellY,
+			s.data.Cell(xcell, ycell),
+		) {
+			// view can not go below rows held.
+			if s.data.Pacman.PosY-s.data.ViewY > OffsetY &&
+				s.data.ViewY > float64(s.data.Row*CellSize) {
+				s.data.ViewY -= speed
+			}
+			s.data.Pacman.PosY -= speed
+			if s.data.Pacman.PosY-radius < float64(ycell*CellSize) {
+				s.data.Pacman.CellY -= 1
+			}
+		}
+	case East:
+		if canMove(
+			radius,
+			s.data.Pacman.PosX+speed,
+			s.data.Pacman.PosY,
+			s.data.Pacman.CellX,
+			s.data.Pacman.CellY,
+			s.data.Cell(xcell, ycell),
+		) {
+			s.data.Pacman.PosX += speed
+			if s.data.Pacman.PosX+radius > float64((xcell*CellSize)+CellSize) {
+				s.data.Pacman.CellX += 1
+			}
+		}
+	case West:
+		if canMove(
+			radius,
+			s.data.Pacman.PosX-speed,
+			s.data.Pacman.PosY,
+			s.data.Pacman.CellX,
+			s.data.Pacman.CellY,
+			s.data.Cell(xcell, ycell),
+		) {
+			s.data.Pacman.PosX -= speed
+			if s.data.Pacman.PosX-radius < float64(xcell*CellSize) {
+				s.data.Pacman.CellX -= 1
+			}
+		}
+	}
+}
+
+func (s *Simulation) getGhostDirection(i int) Direction {
+	ghost := s.data.Ghosts[i]
+
+	if s.data.Pacman.CellX == ghost.CellX && s.data.Pacman.CellY == ghost.CellY {
+		return ghost.Direction
+	}
+
+	// frightened ghosts flee from pacman itself, others
+	// head for target of their mode or strategy.
+	targetX, targetY := s.data.Pacman.CellX, s.data.Pacman.CellY
+	switch ghost.Mode {
+	case ScatterMode:
+		targetX, targetY = scatterTarget(ghost.Kind, s.data.Columns, len(s.data.Grid))
+		targetY += s.data.Row
+	case ChaseMode:
+		strategy, ok := s.strategies[ghost.Kind]
+		if !ok {
+			strategy = Chase
+		}
+		targetX, targetY = strategy(i, s.data, s.rand)
+	case EyesMode:
+		targetX, targetY = ghost.homeX, ghost.homeY
+	}
+	fleeing := ghost.Mode == FrightenedMode
+
+	// distance to target along maze paths, if pathfinder
+	// can afford it, straight line distance otherwise. Cells
+	// not connected to target inside the view come after the
+	// connected ones, by straight line distance.
+	// pathfinder counts rows from start of maze, not world.
+	distances, byPath := s.paths.Distances(targetX, targetY-s.data.Row)
+	distanceOf := func(x, y int) float64 {
+		straight := math.Hypot(float64(x-targetX), float64(y-targetY))
+		if !byPath {
+			return straight
+		}
+		cell := (y-s.data.Row)*s.data.Columns + x
+		if distances[cell] < 0 {
+			return float64(len(distances)) + straight
+		}
+		return float64(distances[cell])
+	}
+
+	next, nextDist := getOppositeDirection(ghost.Direction), 0.0
+	found := false
+	for _, j := range s.rand.Perm(4) {
+		dir := Direction(j)
+		if s.data.Cell(ghost.CellX, ghost.CellY).HasWall(dir) ||
+			dir == getOppositeDirection(ghost.Direction) {
+			continue
+		}
+		nx, ny := neighbour(ghost.CellX, ghost.CellY, dir)
+		// last row in grid might have open North wall
+		if ny < s.data.Row || ny >= s.data.Row+len(s.data.Grid) {
+			continue
+		}
+		dist := distanceOf(nx, ny)
+		if !found || (fleeing && dist > nextDist) || (!fleeing && dist < nextDist) {
+			next, nextDist, found = dir, dist, true
+		}
+	}
+
+	return next
+}
+
+// moveGhost moves ghost by its speed for current mode, a pixel
+// at a time, so it never skips over centre of a cell.
+func (s *Simulation) moveGhost(i int) {
+	s.data.Ghosts[i].travel += s.ghostSpeed(s.data.Ghosts[i].Mode)
+	for s.data.Ghosts[i].travel >= 1 {
+		s.data.Ghosts[i].travel -= 1
+
+		// eyes are checked before stepping, as
+		// they may be sent home from home itself.
+		ghost := s.data.Ghosts[i]
+		if ghost.Mode == EyesMode {
+			x, y := ghost.homeX, ghost.homeY
+			if ghost.CellX == x && ghost.CellY == y &&
+				ghost.PosX == float64((CellSize*x)+(CellSize/2)) &&
+				ghost.PosY == float64((CellSize*y)+(CellSize/2)) {
+				s.data.Ghosts[i].Mode = s.data.Mode
+			}
+		}
+
+		s.stepGhost(i)
+	}
+}
+
+func (s *Simulation) stepGhost(i int) {
+	speed := 1.0
+	radius := s.rules.BodyRadius
+	ghost := s.data.Ghosts[i]
+
+	if ghost.CellY-s.data.Row >= MazeViewSize/CellSize {
+		return
+	}
+
+	if isIntersection(s.data.Cell(ghost.CellX, ghost.CellY)) {
+		if ghost.PosX == float64((CellSize*ghost.CellX)+(CellSize/2)) &&
+			ghost.PosY == float64((CellSize*ghost.CellY)+(CellSize/2)) {
+			s.data.Ghosts[i].Direction = s.getGhostDirection(i)
+		}
+	} else if s.data.Cell(ghost.CellX, ghost.CellY).HasWall(ghost.Direction) ||
+		isDeadend(s.data.Cell(ghost.CellX, ghost.CellY)) {
+		if ghost.PosX == float64((CellSize*ghost.CellX)+(CellSize/2)) &&
+			ghost.PosY == float64((CellSize*ghost.CellY)+(CellSize/2)) {
+			s.data.Ghosts[i].Direction = getExit(s.data.Cell(ghost.CellX, ghost.CellY))
+		}
+	}
+
+	// rows above the view are not in grid, ghosts turn
+	// back at its top row, even where it is open north.
+	if s.data.Ghosts[i].Direction == North &&
+		ghost.CellY-s.data.Row == MazeViewSize/CellSize-1 &&
+		ghost.PosY == float64((CellSize*ghost.CellY)+(CellSize/2)) {
+		s.data.Ghosts[i].Direction = South
+		for _, exit := range s.data.Cell(ghost.CellX, ghost.CellY).Exits() {
+			if exit == East || exit == West {
+				s.data.Ghosts[i].Direction = exit
+				break
+			}
+		}
+	}
+
+	switch s.data.Ghosts[i].Direction {
+	case North:
+		if canMove(
+			radius,
+			s.data.Ghosts[i].PosX,
+			s.data.Ghosts[i].PosY+speed,
+			s.data.Ghosts[i].CellX,
+			s.data.Ghosts[i].CellY,
+			s.data.Cell(s.data.Ghosts[i].CellX, s.data.Ghosts[i].CellY),
+		) {
+			s.data.Ghosts[i].PosY += speed
+			if s.data.Ghosts[i].PosY+radius > float64((s.data.Ghosts[i].CellY*CellSize)+CellSize) {
+				s.data.Ghosts[i].CellY += 1
+			}
+		}
+	case South:
+		if canMove(
+			radius,
+			s.data.Ghosts[i].PosX,
+			s.data.Ghosts[i].PosY-speed,
+			s.data.Ghosts[i].CellX,
+			s.data.Ghosts[i].CellY,
+			s.data.Cell(s.data.Ghosts[i].CellX, s.data.Ghosts[i].CellY),
+		) {
+			s.data.Ghosts[i].PosY -= speed
+			if s.data.Ghosts[i].PosY-radius < float64((s.data.Ghosts[i].CellY * CellSize)) {
+				s.data.Ghosts[i].CellY -= 1
+			}
+		}
+	case East:
+		if canMove(
+			radius,
+			s.data.Ghosts[i].PosX+speed,
+			s.data.Ghosts[i].PosY,
+			s.data.Ghosts[i].CellX,
+			s.data.Ghosts[i].CellY,
+			s.data.Cell(ghost.CellX, ghost.CellY),
+		) {
+			s.data.Ghosts[i].PosX += speed
+			if s.data.Ghosts[i].PosX+radius > float64((s.data.Ghosts[i].CellX*CellSize)+CellSize) {
+				s.data.Ghosts[i].CellX += 1
+			}
+		}
+	case West:
+		if canMove(
+			radius,
+			s.data.Ghosts[i].PosX-speed,
+			s.data.Ghosts[i].PosY,
+			s.data.Ghosts[i].CellX,
+			s.data.Ghosts[i].CellY,
+			s.data.Cell(s.data.Ghosts[i].CellX, s.data.Ghosts[i].CellY),
+		) {
+			s.data.Ghosts[i].PosX -= speed
+			if s.data.Ghosts[i].PosX-radius < float64(s.data.Ghosts[i].CellX*CellSize) {
+				s.data.Ghosts[i].CellX -= 1
+			}
+		}
+	}
+}
+
+func (s *Simulation) pacmanTouchesPower(i int) bool {
+	if s.data.Powers[i].CellX == s.data.Pacman.CellX &&
+		s.data.Powers[i].CellY == s.data.Pacman.CellY {
+		posX := float64((s.data.Powers[i].CellX * CellSize) + CellSize/2)
+		posY := float64((s.data.Powers[i].CellY * CellSize) + CellSize/2)
+		if math.Abs(posX-s.data.Pacman.PosX) < s.rules.PickupRadius &&
+			math.Abs(posY-(s.data.Pacman.PosY)) < s.rules.PickupRadius {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Simulation) pacmanTouchesGhost(i int) bool {
+	if s.data.Ghosts[i].CellX == s.data.Pacman.CellX &&
+		s.data.Ghosts[i].CellY == s.data.Pacman.CellY {
+		posX := s.data.Ghosts[i].PosX
+		posY := s.data.Ghosts[i].PosY
+		if math.Abs(posX-s.data.Pacman.PosX) < s.rules.GhostRadius &&
+			math.Abs(posY-(s.data.Pacman.PosY)) < s.rules.GhostRadius {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,204 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSimulation(t *testing.T) {
+	sim := NewSimulation(1, nil)
+	defer sim.Close()
+	assert.NotNil(t, sim.Data(), "Should not be nil.")
+	assert.Equal(t, 5, sim.Data().Lifes, "Lifes should be same")
+	assert.Equal(t, MazeViewSize/CellSize, len(sim.Data().Grid), "Rows should be same")
+}
+
+func TestStep(t *testing.T) {
+	first, second := NewSimulation(1, nil), NewSimulation(1, nil)
+	defer first.Close()
+	defer second.Close()
+	inputs := []Input{{Up: true}, {Left: true}, {Up: true}, {Right: true}}
+	for i := 0; i < 2000; i++ {
+		input := inputs[(i/50)%len(inputs)]
+		assert.Equal(t, first.Step(input), second.Step(input), "Events should be same")
+	}
+	assert.Equal(t, first.Data(), second.Data(), "Should be equal")
+}
+
+func TestStepGameOver(t *testing.T) {
+	sim := NewSimulation(1, nil)
+	defer sim.Close()
+	sim.Data().Lifes = 0
+	assert.Equal(t, []Event{GameOver}, sim.Step(Input{}), "Should be equal")
+}
+
+func TestSeedKeepsMaze(t *testing.T) {
+	first, second := NewSimulation(7, nil), NewSimulation(7, nil)
+	defer first.Close()
+	defer second.Close()
+	assert.Equal(t, int64(7), first.Seed(), "Seed should be same")
+	// ghost & power choices consume game stream only.
+	for i := 0; i < 100; i++ {
+		second.rand.Int63()
+	}
+	assert.Equal(t, first.maze.Get(0, 40), second.maze.Get(0, 40), "Should be equal")
+	other := NewSimulation(8, nil)
+	defer other.Close()
+	assert.NotEqual(t, first.maze.Get(0, 40), other.maze.Get(0, 40), "Should not be equal")
+}
+
+func TestColumns(t *testing.T) {
+	for _, columns := range []int{MinColumns, MaxColumns} {
+		rules := DefaultRules()
+		rules.Columns = columns
+		sim := NewSimulation(1, rules)
+		defer sim.Close()
+		for i := 0; i < 3000; i++ {
+			sim.Step(Input{Up: i%200 < 100, Left: i%400 < 200, Right: i%400 >= 200})
+		}
+		assert.Equal(t, columns, sim.Data().Columns, "Columns should be same")
+		assert.Equal(t, columns, len(sim.Data().Grid[0]), "Columns should be same")
+		assert.Equal(t, columns, len(sim.Data().Active[0]), "Columns should be same")
+		for _, ghost := range sim.Data().Ghosts {
+			assert.True(t, ghost.CellX >= 0 && ghost.CellX < columns, "Should be in grid")
+		}
+	}
+}
+
+func TestWorldCoordinates(t *testing.T) {
+	rules := DefaultRules()
+	rules.StartLifes = 1000
+	sim := NewSimulation(1, rules)
+	defer sim.Close()
+	data := sim.Data()
+	src := randSrc()
+	input := Input{}
+	capacity := sim.maze.Capacity()
+	for i := 0; i < 20000; i++ {
+		if i%40 == 0 {
+			k := src.Intn(3)
+			input = Input{Up: k == 0, Left: k == 1, Right: k == 2}
+		}
+		sim.Step(input)
+
+		assert.True(t, data.Pacman.CellY >= data.Row && data.Pacman.CellY < data.Row+len(data.Grid),
+			"Pacman should be in view")
+		assert.True(t, data.Depth() >= data.Pacman.CellY, "Depth should be highest row")
+		for _, ghost := range data.Ghosts {
+			assert.True(t, ghost.CellY >= data.Row, "Ghost should not be below view")
+		}
+	}
+	assert.True(t, data.Row > 0, "Maze should have scrolled")
+	assert.Equal(t, capacity, sim.maze.Capacity(), "Ring should keep its capacity")
+	assert.Equal(t, sim.maze.First(), data.Row, "Row should be same")
+	assert.Equal(t, data.Grid[0], sim.maze.Get(0, 1)[0], "Should be equal")
+}
+
+func TestSetRules(t *testing.T) {
+	sim := NewSimulation(1, nil)
+	defer sim.Close()
+	for i := 0; i < 13; i++ {
+		sim.Step(Input{Right: true})
+	}
+	rules := DefaultRules()
+	rules.PacmanSpeed = 8
+	rules.DotScore = 5
+	assert.Nil(t, sim.SetRules(rules), "Should be nil")
+	centre := float64((CellSize * sim.Data().Pacman.CellX) + (CellSize / 2))
+	assert.Equal(t, 0.0, math.Mod(sim.Data().Pacman.PosX-centre, 8), "Pacman should reach centre at new speed")
+	score := sim.Data().Score
+	for i := 0; i < 500; i++ {
+		sim.Step(Input{Up: i%100 < 50, Left: i%100 >= 50})
+	}
+	assert.True(t, sim.Data().Score > score, "Pacman should keep eating dots")
+	assert.Equal(t, 0, (sim.Data().Score-score)%5, "Dots should score by new rules")
+
+	rules = DefaultRules()
+	rules.Columns = 12
+	assert.EqualError(t, sim.SetRules(rules), "rules: columns can not change during a run, got 12, want 10")
+}
+
+// benchmarkRedraw steps a climbing pacman & counts rows of
+// grid a renderer would redraw each frame, as told by given
+// change detection. Drawing itself needs a GPU, these show
+// what is left of a frame without it.
+func benchmarkRedraw(b *testing.B, redraw func(data *Data) int) {
+	rules := DefaultRules()
+	rules.StartLifes = 1000
+	sim := NewSimulation(1, rules)
+	defer sim.Close()
+
+	src := randSrc()
+	input := Input{}
+	rows := 0
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		if i%40 == 0 {
+			k := src.Intn(3)
+			input = Input{Up: k == 0, Left: k == 1, Right: k == 2}
+		}
+		sim.Step(input)
+		data := sim.Data()
+		b.StartTimer()
+		rows += redraw(data)
+	}
+	b.ReportMetric(float64(rows)/float64(b.N), "rows/op")
+}
+
+// BenchmarkRedrawDeepEqual is the baseline, grid is copied &
+// compared cell by cell, every row is redrawn on any change.
+func BenchmarkRedrawDeepEqual(b *testing.B) {
+	var last [][]Cell
+	benchmarkRedraw(b, func(data *Data) int {
+		if gridEqual(last, data.Grid) {
+			return 0
+		}
+		last = make([][]Cell, len(data.Grid))
+		for i := range data.Grid {
+			last[i] = append([]Cell(nil), data.Grid[i]...)
+		}
+		return len(data.Grid)
+	})
+}
+
+// BenchmarkRedrawRowVersions keeps a version per world row,
+// like tiles of MazeView, only rows which changed are redrawn.
+func BenchmarkRedrawRowVersions(b *testing.B) {
+	versions := make(map[int]int)
+	benchmarkRedraw(b, func(data *Data) int {
+		for row := range versions {
+			if row < data.Row || row >= data.Row+len(data.Grid) {
+				delete(versions, row)
+			}
+		}
+		rows := 0
+		for i := range data.Grid {
+			if version, ok := versions[data.Row+i]; !ok || version != data.RowVersions[i] {
+				versions[data.Row+i] = data.RowVersions[i]
+				rows += 1
+			}
+		}
+		return rows
+	})
+}
+
+func gridEqual(previous, next [][]Cell) bool {
+	if len(previous) != len(next) {
+		return false
+	}
+	for i := range next {
+		if len(previous[i]) != len(next[i]) {
+			return false
+		}
+		for j := range next[i] {
+			if previous[i][j] != next[i][j] {
+				return false
+			}
+		}
+	}
+	return true
+}
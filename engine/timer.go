@@ -0,0 +1,53 @@
+package engine
+
+// TicksPerSecond is the rate at which front ends are
+// expected to call Step, it matches ebiten's default TPS.
+const TicksPerSecond = 60
+
+// Timer counts down a timed effect in simulation ticks.
+// Since it only advances on Step, it stops whenever the
+// simulation is not stepped, e.g. while game is paused.
+type Timer struct {
+	duration  int
+	remaining int
+}
+
+// Start (re)starts the timer for given number of ticks.
+func (t *Timer) Start(ticks int) {
+	t.duration = ticks
+	t.remaining = ticks
+}
+
+// Stop ends the timer before it runs out.
+func (t *Timer) Stop() {
+	t.remaining = 0
+}
+
+// Tick advances the timer by a single tick. It returns
+// true only on the tick at which the timer runs out.
+func (t *Timer) Tick() bool {
+	if t.remaining < 1 {
+		return false
+	}
+	t.remaining -= 1
+	return t.remaining == 0
+}
+
+// Active reports whether the timer is still running.
+func (t *Timer) Active() bool {
+	return t.remaining > 0
+}
+
+// Remaining returns number of ticks left before the timer runs out.
+func (t *Timer) Remaining() int {
+	return t.remaining
+}
+
+// Fraction returns remaining time as a fraction of the
+// duration timer was started with, between 0 & 1.
+func (t *Timer) Fraction() float64 {
+	if t.duration < 1 {
+		return 0
+	}
+	return float64(t.remaining) / float64(t.duration)
+}
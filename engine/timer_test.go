@@ -0,0 +1,20 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimer(t *testing.T) {
+	timer := Timer{}
+	assert.False(t, timer.Active(), "Should not be active")
+	timer.Start(3)
+	assert.Equal(t, 1.0, timer.Fraction(), "Should be full")
+	assert.False(t, timer.Tick(), "Should not run out")
+	assert.False(t, timer.Tick(), "Should not run out")
+	assert.Equal(t, 1, timer.Remaining(), "Remaining should be same")
+	assert.True(t, timer.Tick(), "Should run out")
+	assert.False(t, timer.Active(), "Should not be active")
+	assert.False(t, timer.Tick(), "Should not run out again")
+}
@@ -0,0 +1,91 @@
+package engine
+
+func getOppositeDirection(dir Direction) Direction {
+	switch dir {
+	case North:
+		return South
+	case East:
+		return West
+	case South:
+		return North
+	default:
+		return East
+	}
+}
+
+func isIntersection(cell Cell) bool {
+	count := cell.Walls()
+
+	if count >= 3 {
+		return false
+	} else if count == 2 {
+		// covers the case of corridor
+		if cell == WallNorth|WallSouth || cell == WallEast|WallWest {
+			return false
+		}
+	}
+	return true
+}
+
+func isDeadend(cell Cell) bool {
+	return cell.Walls() >= 3
+}
+
+func getExit(cell Cell) Direction {
+	if exits := cell.Exits(); len(exits) > 0 {
+		return exits[0]
+	}
+	return North
+}
+
+func canMove(size float64, posX, posY float64, x, y int, cell Cell) bool {
+	psx := posX - size
+	psy := posY - size
+	pex := posX + size
+	pey := posY + size
+
+	sx := x * CellSize
+	sy := y * CellSize
+	ex := sx + CellSize
+	ey := sy + CellSize
+
+	if cell.HasWall(North) {
+		if pey > float64(ey-12) {
+			return false
+		}
+	}
+	if cell.HasWall(East) {
+		if pex > float64(ex-12) {
+			return false
+		}
+	}
+	if cell.HasWall(South) {
+		if psy < float64(sy+12) {
+			return false
+		}
+	}
+	if cell.HasWall(West) {
+		if psx < float64(sx+12) {
+			return false
+		}
+	}
+
+	// NW corner
+	if pey > float64(ey-12) && psx < float64(sx+12) {
+		return false
+	}
+	// NE
+	if pey > float64(ey-12) && pex > float64(ex-12) {
+		return false
+	}
+	// SW
+	if psy < float64(sy+12) && psx < float64(sx+12) {
+		return false
+	}
+	// SE
+	if psy < float64(sy+12) && pex > float64(ex-12) {
+		return false
+	}
+
+	return true
+}
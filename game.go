@@ -3,32 +3,68 @@
 //
 // How to play:
 // Use direction keys to move pacman. Ghosts try to chase player and
-// on collision player looses a life. Player starts with 5 lives and
-// can have upto 7. Collect diamond to increase lives. Use flask to
+// on collision player looses a life. By default player starts with
+// 5 lives and can have upto 7. Collect diamond to increase lives. Use flask to
 // gain ability to destroy ghosts, ability lasts for 10 seconds & ghosts
 // try to runaway from player. Eating a ghost gives bonus of 200 points.
 package pacman
 
 import (
-	"math"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"log"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/hajimehoshi/ebiten"
 	"github.com/skatiyar/pacman/assets"
+	"github.com/skatiyar/pacman/engine"
 )
 
 type gameState int
 
 type Game struct {
-	state       gameState
-	rand        *rand.Rand
-	maze        *Maze
-	data        *Data
-	skinView    func(gameState, *Data) (*ebiten.Image, error)
-	gridView    func(gameState, *Data) (*ebiten.Image, error)
-	direction   direction
-	powerTicker *time.Ticker
+	state   gameState
+	rand    *rand.Rand
+	seed    int64
+	hasSeed bool
+	sim     *engine.Simulation
+
+	rules      *engine.Rules
+	generator  string
+	columns    int
+	difficulty engine.DifficultyTable
+	// theme is directory of files overriding embedded assets.
+	theme string
+	// dev mode watches theme & rulesFile, reload loads
+	// them into running game once they change.
+	dev       bool
+	rulesFile string
+	reload    *reloader
+
+	// recording holds inputs of current run, it is saved
+	// to replayDir on game over, if one is set.
+	recording *engine.Replay
+	replayDir string
+	// playback when set, replaces keys of keyboard.
+	playback *engine.Replay
+	tick     int
+	keys     func() engine.Input
+	// retuned marks a run whose rules changed midway, its
+	// replay would not play back, so it is not saved.
+	retuned bool
+
+	views *views
+	// width & height of skin, it is fitted to the maze.
+	width, height int
+	// window is size of screen when not in fullscreen, in
+	// pixels of art, zero fits it to the skin. It follows
+	// the window as player resizes it.
+	window     image.Point
+	fullscreen bool
 
 	audio *Audio
 }
@@ -38,228 +74,191 @@ const (
 	GameStart
 	GamePause
 	GameOver
-
-	OffsetY = CellSize * 10
 )
 
+// maxRandomSeed keeps generated seeds short enough
+// to read off the screen and share.
+const maxRandomSeed = 1000000000
+
 func NewGame() (*Game, error) {
-	lAssets, assetsErr := assets.LoadAssets()
+	return NewGameWithOptions()
+}
+
+func NewGameWithOptions(options ...Option) (*Game, error) {
+	game := &Game{
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		state:     GameLoading,
+		replayDir: DefaultReplayDir(),
+		keys:      keyboardInput,
+	}
+	for _, option := range options {
+		option(game)
+	}
+
+	if game.rules == nil {
+		game.rules = engine.DefaultRules()
+	}
+	rules, rulesErr := game.override(game.rules)
+	if rulesErr != nil {
+		return nil, rulesErr
+	}
+	game.rules = rules
+	if game.playback != nil {
+		if checkErr := game.playback.Check(game.rules); checkErr != nil {
+			return nil, checkErr
+		}
+	}
+
+	theme := assets.NewThemeLoader(game.theme)
+	lAssets, assetsErr := theme.LoadAssets()
 	if assetsErr != nil {
 		return nil, assetsErr
 	}
 
-	mazeView, mazeViewErr := MazeView(lAssets.Walls)
+	audio, audioErr := NewAudio(theme)
+	if audioErr != nil {
+		return nil, audioErr
+	}
+	game.audio = audio
+
+	views, viewsErr := loadViews(lAssets, game.rules.Columns)
+	if viewsErr != nil {
+		return nil, viewsErr
+	}
+	game.views = views
+	game.width, game.height = skinSize(lAssets.Skin, game.rules.Columns)
+	if game.window == (image.Point{}) {
+		game.window = image.Pt(game.width, game.height)
+	}
+	if game.dev {
+		game.reload = newReloader(game.theme, game.rulesFile)
+	}
+
+	return game, nil
+}
+
+// override returns copy of given rules, with generator,
+// columns & difficulty picked by options, if any.
+func (g *Game) override(rules *engine.Rules) (*engine.Rules, error) {
+	if g.generator == "" && g.columns == 0 && g.difficulty == nil {
+		return rules, nil
+	}
+	overridden := *rules
+	if g.generator != "" {
+		overridden.Generator = g.generator
+	}
+	if g.columns != 0 {
+		overridden.Columns = g.columns
+	}
+	if g.difficulty != nil {
+		overridden.Difficulty = g.difficulty
+	}
+	if validateErr := overridden.Validate(); validateErr != nil {
+		return nil, validateErr
+	}
+	return &overridden, nil
+}
+
+// views draw skin & grid of the game.
+type views struct {
+	skin   func(gameState, *engine.Data) (*ebiten.Image, error)
+	grid   func(gameState, *engine.Data) (*ebiten.Image, error)
+	images images
+}
+
+// loadViews makes views drawing the game with given
+// assets, for a maze of given columns. Images made
+// so far are disposed of, if any view fails.
+func loadViews(lAssets *assets.Assets, columns int) (*views, error) {
+	made := images{}
+
+	mazeView, mazeViewErr := MazeView(lAssets.Walls, columns, &made)
 	if mazeViewErr != nil {
+		made.dispose()
 		return nil, mazeViewErr
 	}
 
 	gridView, gridViewErr := GridView(lAssets.Characters, lAssets.Powers,
-		lAssets.ArcadeFont, mazeView)
+		lAssets.ArcadeFont, lAssets.Palette, mazeView, columns, &made)
 	if gridViewErr != nil {
+		made.dispose()
 		return nil, gridViewErr
 	}
 
-	skinView, skinViewErr := SkinView(lAssets.Skin, lAssets.Powers, lAssets.ArcadeFont)
+	skinView, skinViewErr := SkinView(lAssets.Skin, lAssets.Powers,
+		lAssets.ArcadeFont, lAssets.Palette, columns, &made)
 	if skinViewErr != nil {
+		made.dispose()
 		return nil, skinViewErr
 	}
 
-	audio, audioErr := NewAudio()
-	if audioErr != nil {
-		return nil, audioErr
-	}
+	return &views{skin: skinView, grid: gridView, images: made}, nil
+}
 
-	return &Game{
-		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
-		state:    GameLoading,
-		skinView: skinView,
-		gridView: gridView,
-		audio:    audio,
-	}, nil
+// dispose disposes of images of views, which
+// can not be drawn once disposed.
+func (v *views) dispose() {
+	v.images.dispose()
 }
 
 func (g *Game) update(screen *ebiten.Image) error {
+	// window may have been resized, its size is
+	// kept for switching back from fullscreen.
+	if !g.fullscreen {
+		g.window = image.Pt(screen.Size())
+	}
+	if fullscreenReleased() {
+		g.fullscreen = !g.fullscreen
+		ebiten.SetFullscreen(g.fullscreen)
+		ebiten.SetScreenSize(g.screenSize())
+	}
+	if g.reload != nil && g.reload.changed() {
+		g.reload.fail(g.reloadFiles())
+	}
+
 	switch g.state {
 	case GameLoading:
 		if spaceReleased() {
-			xcol := g.rand.Intn(Columns)
-			numOfRows := MazeViewSize / CellSize
-			g.data = NewData()
-			g.maze = NewPopulatedMaze(32, g.rand)
-			g.data.grid = g.maze.Get(0, numOfRows)
-			g.data.active = make([][Columns]bool, numOfRows, numOfRows)
-			g.data.pacman = Pacman{
-				Position{
-					cellX:     xcol,
-					cellY:     0,
-					posX:      float64((xcol * CellSize) + (CellSize / 2)),
-					posY:      CellSize / 2,
-					direction: North,
-				},
-			}
-			g.direction = g.data.pacman.direction
-			g.data.active[0][xcol] = true
-
-			powers := make([]Power, 0)
-			for i := 0; i < numOfRows; i += 4 {
-				cellX := g.rand.Intn(Columns)
-				cellY := g.rand.Intn(4) + i
-				kind := Invincibility
-				if (cellY-i)%2 == 0 {
-					kind = Life
-				}
-				powers = append(powers, NewPower(cellX, cellY, kind))
-			}
-			g.data.powers = powers
-
-			ghosts := make([]Ghost, 0)
-			for i := 0; i < numOfRows; i += 2 {
-				cellX := g.rand.Intn(Columns/2) + Columns/2
-				if i%4 == 0 {
-					cellX = g.rand.Intn(Columns / 2)
-				}
-				cellY := g.rand.Intn(2) + i
-				kind := Ghost1
-				if (cellY-i)%4 == 0 {
-					kind = Ghost4
-				} else if (cellY-i)%3 == 0 {
-					kind = Ghost3
-				} else if (cellY-i)%2 == 0 {
-					kind = Ghost2
-				}
-				ghosts = append(ghosts, NewGhost(cellX, cellY, kind, getExit(
-					g.data.grid[cellY][cellX])))
-			}
-			g.data.ghosts = ghosts
+			g.start()
 
 			g.audio.players.Beginning.Pause()
 			g.audio.players.Beginning.Rewind()
-
-			g.state = GameStart
 		} else {
-			g.data = nil
-			g.maze = nil
+			if g.sim != nil {
+				g.sim.Close()
+			}
+			g.sim = nil
 
 			g.audio.players.Beginning.Play()
 		}
 	case GameStart:
 		if spaceReleased() {
 			g.state = GamePause
-		} else if g.data.lifes < 1 {
-			g.state = GameOver
 		} else {
-			numOfRows := MazeViewSize / CellSize
-			if g.data.pacman.cellY == len(g.data.grid)-8 {
-				g.maze.Compact(4)
-				if (g.maze.Rows() - numOfRows) < 4 {
-					g.maze.GrowBy(16)
-				}
-
-				g.data.grid = g.maze.Get(0, numOfRows)
-				// shift active grid by 4
-				for i := 4; i <= len(g.data.active); i++ {
-					for j := 0; j < Columns; j++ {
-						if i <= g.data.pacman.cellY {
-							g.data.active[i-4][j] = g.data.active[i][j]
-						} else {
-							g.data.active[i-4][j] = false
-						}
-					}
-				}
-
-				g.data.pacman.cellY -= 4
-				g.data.gridOffsetY -= CellSize * 4
-
-				for i := 0; i < len(g.data.powers); i++ {
-					g.data.powers[i].cellY -= 4
-					if g.data.powers[i].cellY < 0 {
-						cellX := g.rand.Intn(Columns)
-						cellY := g.rand.Intn(4) + (numOfRows - 4)
-						g.data.powers[i] = NewPower(cellX, cellY, g.data.powers[i].kind)
-					}
-				}
-				for i := 0; i < len(g.data.ghosts); i++ {
-					g.data.ghosts[i].cellY -= 4
-					g.data.ghosts[i].posY -= CellSize * 4
-					if g.data.ghosts[i].cellY < 0 {
-						cellX := g.rand.Intn(Columns)
-						cellY := g.rand.Intn(4) + (numOfRows - 4)
-						g.data.ghosts[i] = NewGhost(
-							cellX, cellY,
-							g.data.ghosts[i].kind,
-							getExit(g.data.grid[cellY][cellX]))
-					}
-				}
-			}
-
-			g.keybord()
-			g.movePacman()
-
-			if !g.data.active[g.data.pacman.cellY][g.data.pacman.cellX] {
-				if math.Abs(float64(
-					(g.data.pacman.cellX*CellSize)+(CellSize/2),
-				)-(g.data.pacman.posX)) < 20 &&
-					math.Abs(float64(
-						(g.data.pacman.cellY*CellSize)+(CellSize/2),
-					)-(g.data.pacman.posY+g.data.gridOffsetY)) < 20 {
-					g.data.active[g.data.pacman.cellY][g.data.pacman.cellX] = true
-					g.data.score += 1
+			for _, event := range g.step() {
+				switch event {
+				case engine.DotEaten:
 					if g.audio.players.Chomp.IsPlaying() {
 						g.audio.players.Chomp.Pause()
 					}
 					g.audio.players.Chomp.Rewind()
 					g.audio.players.Chomp.Play()
-				}
-			}
-
-			// check powers
-			for i := 0; i < len(g.data.powers); i++ {
-				cellX := g.rand.Intn(Columns)
-				cellY := g.rand.Intn(4) +
-					(((g.data.powers[i].cellY / 4) * 4) + numOfRows)
-				if g.pacmanTouchesPower(i) {
-					switch g.data.powers[i].kind {
-					case Life:
-						if g.data.lifes < MaxLifes {
-							g.data.lifes += 1
-							g.data.powers[i] = NewPower(cellX, cellY, g.data.powers[i].kind)
-							if !g.audio.players.ExtraPac.IsPlaying() {
-								g.audio.players.ExtraPac.Rewind()
-								g.audio.players.ExtraPac.Play()
-							}
-						}
-					case Invincibility:
-						if !g.data.invincible {
-							g.data.invincible = true
-						}
-						g.startCountdown(10)
-						g.data.powers[i] = NewPower(cellX, cellY, g.data.powers[i].kind)
-						if !g.audio.players.EatFlask.IsPlaying() {
-							g.audio.players.EatFlask.Rewind()
-							g.audio.players.EatFlask.Play()
-						}
+				case engine.LifeGained:
+					if !g.audio.players.ExtraPac.IsPlaying() {
+						g.audio.players.ExtraPac.Rewind()
+						g.audio.players.ExtraPac.Play()
 					}
-				}
-			}
-			// check ghosts
-			for i := 0; i < len(g.data.ghosts); i++ {
-				if g.pacmanTouchesGhost(i) {
-					if !g.data.invincible {
-						g.data.lifes -= 1
-					} else {
-						g.data.score += 200
-						if !g.audio.players.EatGhost.IsPlaying() {
-							g.audio.players.EatGhost.Rewind()
-							g.audio.players.EatGhost.Play()
-						}
+				case engine.InvincibilityGained:
+					if !g.audio.players.EatFlask.IsPlaying() {
+						g.audio.players.EatFlask.Rewind()
+						g.audio.players.EatFlask.Play()
+					}
+				case engine.GhostEaten:
+					if !g.audio.players.EatGhost.IsPlaying() {
+						g.audio.players.EatGhost.Rewind()
+						g.audio.players.EatGhost.Play()
 					}
-					cellX := g.rand.Intn(Columns)
-					cellY := g.rand.Intn(4) +
-						(((g.data.ghosts[i].cellY / 4) * 4) + numOfRows)
-					g.data.ghosts[i] = NewGhost(
-						cellX, cellY, g.data.ghosts[i].kind, North)
 				}
-				g.moveGhost(i)
 			}
 		}
 	case GamePause:
@@ -285,489 +284,163 @@ func (g *Game) update(screen *ebiten.Image) error {
 		return nil
 	}
 
-	sview, sviewErr := g.skinView(g.state, g.data)
+	var data *engine.Data
+	if g.sim != nil {
+		data = g.sim.Data()
+	}
+
+	sview, sviewErr := g.views.skin(g.state, data)
 	if sviewErr != nil {
 		return sviewErr
 	}
 
-	gview, gviewErr := g.gridView(g.state, g.data)
+	gview, gviewErr := g.views.grid(g.state, data)
 	if gviewErr != nil {
 		return gviewErr
 	}
 
+	screenWidth, screenHeight := screen.Size()
+	layout := NewLayout(g.width, g.height, screenWidth, screenHeight)
+
+	// hud & frame are cut from skin, so
+	// they can be placed apart.
 	ops := &ebiten.DrawImageOptions{}
+	hud := image.Rect(0, 0, g.width, hudHeight)
+	ops.SourceRect = &hud
+	ops.GeoM.Scale(layout.Scale, layout.Scale)
+	ops.GeoM.Translate(float64(layout.Hud.X), float64(layout.Hud.Y))
+	if drawErr := screen.DrawImage(sview, ops); drawErr != nil {
+		return drawErr
+	}
+
+	frame := image.Rect(0, hudHeight, g.width, g.height)
+	ops.SourceRect = &frame
 	ops.GeoM.Reset()
+	ops.GeoM.Scale(layout.Scale, layout.Scale)
+	ops.GeoM.Translate(float64(layout.Frame.X), float64(layout.Frame.Y))
 	if drawErr := screen.DrawImage(sview, ops); drawErr != nil {
 		return drawErr
 	}
 
+	ops.SourceRect = nil
 	ops.GeoM.Reset()
-	ops.GeoM.Translate(38, 162)
+	ops.GeoM.Scale(layout.Scale, layout.Scale)
+	ops.GeoM.Translate(float64(layout.Grid.X), float64(layout.Grid.Y))
 	if drawErr := screen.DrawImage(gview, ops); drawErr != nil {
 		return drawErr
 	}
 
+	if g.reload != nil {
+		if drawErr := g.reload.drawError(screen, screenWidth); drawErr != nil {
+			return drawErr
+		}
+	}
+
 	return nil
 }
 
 func (g *Game) Run() error {
+	setWindowResizable(true)
+	ebiten.SetFullscreen(g.fullscreen)
+	width, height := g.screenSize()
 	return ebiten.Run(func(screen *ebiten.Image) error {
 		return g.update(screen)
-	}, 712, 1220, 0.5, "PACMAN") // scale is kept to 0.5, for good rendering in retina.
+	}, width, height, windowScale, "PACMAN")
 }
 
-func (g *Game) keybord() {
-	if g.data != nil {
-		walls := g.data.grid[g.data.pacman.cellY][g.data.pacman.cellX]
-		if upKeyPressed() {
-			if walls[0] == '_' {
-				g.direction = North
-			}
-		}
-		if downKeyPressed() {
-			if walls[2] == '_' {
-				g.direction = South
-			}
-		}
-		if leftKeyPressed() {
-			if walls[3] == '_' {
-				g.direction = West
-			}
-		}
-		if rightKeyPressed() {
-			if walls[1] == '_' {
-				g.direction = East
-			}
-		}
+// screenSize returns size of screen in pixels of art, which
+// fills the monitor in fullscreen, or the window otherwise.
+// Game is laid out afresh on every frame, to fit the screen.
+func (g *Game) screenSize() (int, int) {
+	if g.fullscreen {
+		width, height := ebiten.ScreenSizeInFullscreen()
+		return int(float64(width) / windowScale), int(float64(height) / windowScale)
 	}
+	return g.window.X, g.window.Y
 }
 
-func (g *Game) startCountdown(duration int) {
-	if g.powerTicker != nil {
-		g.powerTicker.Stop()
-	}
-
-	g.powerTicker = time.NewTicker(time.Duration(duration) * time.Second)
-	go func() {
-		select {
-		case <-g.powerTicker.C:
-			g.data.invincible = false
-		}
-	}()
+// start sets up a new run, from seed given
+// by options or a random one otherwise.
+func (g *Game) start() {
+	seed := g.seed
+	if !g.hasSeed {
+		seed = g.rand.Int63n(maxRandomSeed)
+	}
+	if g.sim != nil {
+		g.sim.Close()
+	}
+	g.sim = engine.NewSimulation(seed, g.rules)
+	g.recording = engine.NewReplay(seed, g.rules)
+	g.tick = 0
+	g.retuned = false
+	g.state = GameStart
 }
 
-func (g *Game) movePacman() {
-	speed := 2.0
-	xcell := g.data.pacman.cellX
-	ycell := g.data.pacman.cellY
-
-	switch g.direction {
-	case North, South:
-		if g.data.pacman.posX == float64((CellSize*xcell)+(CellSize/2)) {
-			g.data.pacman.direction = g.direction
-		}
-	case East, West:
-		if g.data.pacman.posY+g.data.gridOffsetY == float64((CellSize*ycell)+(CellSize/2)) {
-			g.data.pacman.direction = g.direction
-		}
-	}
-
-	switch g.data.pacman.direction {
-	case North:
-		if canMove(
-			20.0,
-			g.data.pacman.posX,
-			g.data.pacman.posY+g.data.gridOffsetY+speed,
-			g.data.pacman.cellX,
-			g.data.pacman.cellY,
-			g.data.grid[ycell][xcell],
-		) {
-			if g.data.pacman.posY > OffsetY {
-				g.data.gridOffsetY += speed
-			} else {
-				g.data.pacman.posY += speed
-			}
-			if g.data.pacman.posY+g.data.gridOffsetY+20 > float64((ycell*CellSize)+CellSize) {
-				g.data.pacman.cellY += 1
-			}
-		}
-	case South:
-		if canMove(
-			20.0,
-			g.data.pacman.posX,
-			g.data.pacman.posY+g.data.gridOffsetY-speed,
-			g.data.pacman.cellX,
-			g.data.pacman.cellY,
-			g.data.grid[ycell][xcell],
-		) {
-			if g.data.pacman.posY > OffsetY && g.data.gridOffsetY > 0 {
-				g.data.gridOffsetY -= speed
-			} else {
-				g.data.pacman.posY -= speed
-			}
-			if g.data.pacman.posY+g.data.gridOffsetY-20 < float64(ycell*CellSize) {
-				g.data.pacman.cellY -= 1
-			}
-		}
-	case East:
-		if canMove(
-			20.0,
-			g.data.pacman.posX+speed,
-			g.data.pacman.posY+g.data.gridOffsetY,
-			g.data.pacman.cellX,
-			g.data.pacman.cellY,
-			g.data.grid[ycell][xcell],
-		) {
-			g.data.pacman.posX += speed
-			if g.data.pacman.posX+20 > float64((xcell*CellSize)+CellSize) {
-				g.data.pacman.cellX += 1
-			}
-		}
-	case West:
-		if canMove(
-			20.0,
-			g.data.pacman.posX-speed,
-			g.data.pacman.posY+g.data.gridOffsetY,
-			g.data.pacman.cellX,
-			g.data.pacman.cellY,
-			g.data.grid[ycell][xcell],
-		) {
-			g.data.pacman.posX -= speed
-			if g.data.pacman.posX-20 < float64(xcell*CellSize) {
-				g.data.pacman.cellX -= 1
-			}
-		}
-	}
-}
-
-func (g *Game) getGhostDirection(i int) direction {
-	pacX := float64((g.data.pacman.cellX * CellSize) + (CellSize / 2))
-	pacY := float64((g.data.pacman.cellY * CellSize) + (CellSize / 2))
-
-	ghost := g.data.ghosts[i]
-
-	if g.data.pacman.cellX == ghost.cellX && g.data.pacman.cellY == ghost.cellY {
-		return ghost.direction
-	}
-
-	x, y := ghost.cellX, ghost.cellY
-	ghsX := float64((x * CellSize) + (CellSize / 2))
-	ghsY := float64((y * CellSize) + (CellSize / 2))
-
-	// since longest path can be m*n
-	prevDist := float64((MazeViewSize/CellSize)*Columns) * CellSize
-	if g.data.invincible {
-		prevDist = 0.0
-	}
-
-	for j := range g.rand.Perm(4) {
-		if g.data.grid[ghost.cellY][ghost.cellX][j] == '_' {
-			nx, ny := 0, 0
-			dist := 0.0
-			switch j {
-			case 0: // North
-				// Added to prevent array overflow panic,
-				// since last row in grid might have open North wall
-				if y+1 < MazeViewSize/CellSize {
-					dist = math.Sqrt(math.Pow(ghsX-pacX, 2) + math.Pow((ghsY+CellSize)-pacY, 2))
-					nx, ny = ghost.cellX, ghost.cellY+1
-				}
-			case 1: // East
-				dist = math.Sqrt(math.Pow((ghsX+CellSize)-pacX, 2) + math.Pow(ghsY-pacY, 2))
-				nx, ny = ghost.cellX+1, ghost.cellY
-			case 2: // South
-				dist = math.Sqrt(math.Pow(ghsX-pacX, 2) + math.Pow((ghsY-CellSize)-pacY, 2))
-				nx, ny = ghost.cellX, ghost.cellY-1
-			case 3: // West
-				dist = math.Sqrt(math.Pow((ghsX-CellSize)-pacX, 2) + math.Pow(ghsY-pacY, 2))
-				nx, ny = ghost.cellX-1, ghost.cellY
-			}
-			if g.directionOfCell(ghost.cellX, ghost.cellY, nx, ny) !=
-				getOppositeDirection(ghost.direction) {
-				if g.data.invincible {
-					if dist > prevDist {
-						x, y, prevDist = nx, ny, dist
-					}
-				} else {
-					if dist < prevDist {
-						x, y, prevDist = nx, ny, dist
-					}
-				}
-			}
-		}
-	}
-
-	return g.directionOfCell(ghost.cellX, ghost.cellY, x, y)
-}
-
-func (g *Game) moveGhost(i int) {
-	speed := 1.0
-	ghost := g.data.ghosts[i]
-
-	if ghost.cellY >= MazeViewSize/CellSize {
-		return
-	}
-
-	if isIntersection(g.data.grid[ghost.cellY][ghost.cellX]) {
-		if ghost.posX == float64((CellSize*ghost.cellX)+(CellSize/2)) &&
-			ghost.posY == float64((CellSize*ghost.cellY)+(CellSize/2)) {
-			g.data.ghosts[i].direction = g.getGhostDirection(i)
-		}
-	} else if isBlocked(g.data.grid[ghost.cellY][ghost.cellX], ghost.direction) ||
-		isDeadend(g.data.grid[ghost.cellY][ghost.cellX]) {
-		if ghost.posX == float64((CellSize*ghost.cellX)+(CellSize/2)) &&
-			ghost.posY == float64((CellSize*ghost.cellY)+(CellSize/2)) {
-			g.data.ghosts[i].direction = getExit(g.data.grid[ghost.cellY][ghost.cellX])
-		}
+// step runs a tick of the run & records its input. Run is
+// over once simulation says so, or replay being played runs
+// out of inputs, recording is saved then.
+func (g *Game) step() []engine.Event {
+	input, ok := g.input()
+	if !ok {
+		g.state = GameOver
+		return nil
 	}
-
-	switch g.data.ghosts[i].direction {
-	case North:
-		if canMove(
-			20.0,
-			g.data.ghosts[i].posX,
-			g.data.ghosts[i].posY+speed,
-			g.data.ghosts[i].cellX,
-			g.data.ghosts[i].cellY,
-			g.data.grid[g.data.ghosts[i].cellY][g.data.ghosts[i].cellX],
-		) {
-			g.data.ghosts[i].posY += speed
-			if g.data.ghosts[i].posY+20 > float64((g.data.ghosts[i].cellY*CellSize)+CellSize) {
-				g.data.ghosts[i].cellY += 1
-			}
-		}
-	case South:
-		if canMove(
-			20.0,
-			g.data.ghosts[i].posX,
-			g.data.ghosts[i].posY-speed,
-			g.data.ghosts[i].cellX,
-			g.data.ghosts[i].cellY,
-			g.data.grid[g.data.ghosts[i].cellY][g.data.ghosts[i].cellX],
-		) {
-			g.data.ghosts[i].posY -= speed
-			if g.data.ghosts[i].posY-20 < float64((g.data.ghosts[i].cellY * CellSize)) {
-				g.data.ghosts[i].cellY -= 1
-			}
-		}
-	case East:
-		if canMove(
-			20.0,
-			g.data.ghosts[i].posX+speed,
-			g.data.ghosts[i].posY,
-			g.data.ghosts[i].cellX,
-			g.data.ghosts[i].cellY,
-			g.data.grid[ghost.cellY][ghost.cellX],
-		) {
-			g.data.ghosts[i].posX += speed
-			if g.data.ghosts[i].posX+20 > float64((g.data.ghosts[i].cellX*CellSize)+CellSize) {
-				g.data.ghosts[i].cellX += 1
-			}
-		}
-	case West:
-		if canMove(
-			20.0,
-			g.data.ghosts[i].posX-speed,
-			g.data.ghosts[i].posY,
-			g.data.ghosts[i].cellX,
-			g.data.ghosts[i].cellY,
-			g.data.grid[g.data.ghosts[i].cellY][g.data.ghosts[i].cellX],
-		) {
-			g.data.ghosts[i].posX -= speed
-			if g.data.ghosts[i].posX-20 < float64(g.data.ghosts[i].cellX*CellSize) {
-				g.data.ghosts[i].cellX -= 1
+	g.recording.Record(input)
+	events := g.sim.Step(input)
+	for _, event := range events {
+		if event == engine.GameOver {
+			g.state = GameOver
+			// a replay which could not be saved is
+			// no reason to end the game.
+			if saveErr := g.saveRecording(); saveErr != nil {
+				log.Printf("replay: %v", saveErr)
 			}
 		}
 	}
+	return events
 }
 
-func (g *Game) pacmanTouchesPower(i int) bool {
-	if g.data.powers[i].cellX == g.data.pacman.cellX &&
-		g.data.powers[i].cellY == g.data.pacman.cellY {
-		posX := float64((g.data.powers[i].cellX * CellSize) + CellSize/2)
-		posY := float64((g.data.powers[i].cellY * CellSize) + CellSize/2)
-		if math.Abs(posX-g.data.pacman.posX) < 20 &&
-			math.Abs(posY-(g.data.pacman.posY+g.data.gridOffsetY)) < 20 {
-			return true
-		}
-	}
-
-	return false
-}
-
-func (g *Game) pacmanTouchesGhost(i int) bool {
-	if g.data.ghosts[i].cellX == g.data.pacman.cellX &&
-		g.data.ghosts[i].cellY == g.data.pacman.cellY {
-		posX := g.data.ghosts[i].posX
-		posY := g.data.ghosts[i].posY
-		if math.Abs(posX-g.data.pacman.posX) < 30 &&
-			math.Abs(posY-(g.data.pacman.posY+g.data.gridOffsetY)) < 30 {
-			return true
-		}
-	}
-
-	return false
-}
-
-func (g *Game) directionOfCell(cx, cy, nx, ny int) direction {
-	if cx < nx {
-		return East
-	}
-	if cx > nx {
-		return West
-	}
-	if cy < ny {
-		return North
-	}
-	if cy > ny {
-		return South
-	}
-
-	if cx%2 == 0 {
-		return West
-	}
-	return East
-}
-
-func getOppositeDirection(dir direction) direction {
-	switch dir {
-	case North:
-		return South
-	case East:
-		return West
-	case South:
-		return North
-	default:
-		return East
-	}
-}
-
-func numOfWalls(walls [4]rune) int {
-	count := 0
-	if walls[0] == 'N' {
-		count += 1
-	}
-	if walls[1] == 'E' {
-		count += 1
-	}
-	if walls[2] == 'S' {
-		count += 1
-	}
-	if walls[3] == 'W' {
-		count += 1
-	}
-	return count
-}
-
-func isIntersection(walls [4]rune) bool {
-	count := numOfWalls(walls)
-
-	if count >= 3 {
-		return false
-	} else if count == 2 {
-		// covers the case of corridor
-		if walls[0] == walls[2] || walls[1] == walls[3] {
-			return false
-		}
-	}
-	return true
-}
-
-func isDeadend(walls [4]rune) bool {
-	if numOfWalls(walls) >= 3 {
-		return true
+// input returns input for next tick, from keyboard or replay
+// being played. It returns false once replay runs out of inputs.
+func (g *Game) input() (engine.Input, bool) {
+	if g.playback == nil {
+		return g.keys(), true
 	}
-	return false
-}
-
-func getExit(walls [4]rune) direction {
-	for i := 0; i < 4; i++ {
-		if walls[i] == '_' {
-			switch i {
-			case 0:
-				return North
-			case 1:
-				return East
-			case 2:
-				return South
-			case 3:
-				return West
-			}
-		}
+	if g.tick >= len(g.playback.Inputs) {
+		return engine.Input{}, false
 	}
-	return North
+	g.tick += 1
+	return g.playback.Inputs[g.tick-1], true
 }
 
-func isBlocked(walls [4]rune, dir direction) bool {
-	switch dir {
-	case North:
-		if walls[0] != '_' {
-			return true
-		}
-	case East:
-		if walls[1] != '_' {
-			return true
-		}
-	case South:
-		if walls[2] != '_' {
-			return true
-		}
-	case West:
-		if walls[3] != '_' {
-			return true
-		}
+// DefaultReplayDir returns directory every finished run is
+// saved in, unless told otherwise, within config directory of
+// user. It is empty if user has none.
+func DefaultReplayDir() string {
+	dir, dirErr := os.UserConfigDir()
+	if dirErr != nil {
+		return ""
 	}
-	return false
+	return filepath.Join(dir, "pacman", "replays")
 }
 
-func canMove(size float64, posX, posY float64, x, y int, walls [4]rune) bool {
-	psx := posX - size
-	psy := posY - size
-	pex := posX + size
-	pey := posY + size
-
-	sx := x * CellSize
-	sy := y * CellSize
-	ex := sx + CellSize
-	ey := sy + CellSize
-
-	if walls[0] == 'N' {
-		if pey > float64(ey-12) {
-			return false
-		}
-	}
-	if walls[1] == 'E' {
-		if pex > float64(ex-12) {
-			return false
-		}
-	}
-	if walls[2] == 'S' {
-		if psy < float64(sy+12) {
-			return false
-		}
+// saveRecording writes replay of current run to replayDir.
+// Runs which are replays themselves are not saved again,
+// nor are runs whose rules were reloaded midway, as their
+// replays would not play back under any one set of rules.
+func (g *Game) saveRecording() error {
+	if g.replayDir == "" || g.playback != nil || g.retuned {
+		return nil
 	}
-	if walls[3] == 'W' {
-		if psx < float64(sx+12) {
-			return false
-		}
+	if dirErr := os.MkdirAll(g.replayDir, 0755); dirErr != nil {
+		return dirErr
 	}
 
-	// NW corner
-	if pey > float64(ey-12) && psx < float64(sx+12) {
-		return false
-	}
-	// NE
-	if pey > float64(ey-12) && pex > float64(ex-12) {
-		return false
-	}
-	// SW
-	if psy < float64(sy+12) && psx < float64(sx+12) {
-		return false
-	}
-	// SE
-	if psy < float64(sy+12) && pex > float64(ex-12) {
-		return false
+	data, dataErr := g.recording.MarshalBinary()
+	if dataErr != nil {
+		return dataErr
 	}
 
-	return true
+	name := fmt.Sprintf("pacman-%d-%d.replay", g.recording.Seed, time.Now().Unix())
+	return ioutil.WriteFile(filepath.Join(g.replayDir, name), data, 0644)
 }
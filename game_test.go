@@ -0,0 +1,68 @@
+package pacman
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/skatiyar/pacman/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordingPlaysBack(t *testing.T) {
+	dir, dirErr := ioutil.TempDir("", "replays")
+	if !assert.Nil(t, dirErr, "Should be nil") {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// keys of player are pressed at random.
+	src := rand.New(rand.NewSource(1))
+	input := engine.Input{}
+	rules := engine.DefaultRules()
+	rules.StartLifes = 1
+	played := &Game{
+		rand:      src,
+		rules:     rules,
+		replayDir: dir,
+		keys: func() engine.Input {
+			if src.Intn(40) == 0 {
+				k := src.Intn(4)
+				input = engine.Input{Up: k == 0, Down: k == 1, Left: k == 2, Right: k == 3}
+			}
+			return input
+		},
+	}
+	played.start()
+	defer played.sim.Close()
+	for tick := 0; played.state == GameStart && tick < 100000; tick++ {
+		played.step()
+	}
+	assert.Equal(t, GameOver, played.state, "Run should be over")
+
+	files, filesErr := filepath.Glob(filepath.Join(dir, "*.replay"))
+	if !assert.Nil(t, filesErr, "Should be nil") || !assert.Len(t, files, 1, "Run should be saved") {
+		return
+	}
+	data, dataErr := ioutil.ReadFile(files[0])
+	assert.Nil(t, dataErr, "Should be nil")
+	recorded := &engine.Replay{}
+	if !assert.Nil(t, recorded.UnmarshalBinary(data), "Should be nil") {
+		return
+	}
+
+	replayed := &Game{rules: rules, replayDir: dir}
+	WithReplay(recorded)(replayed)
+	replayed.start()
+	defer replayed.sim.Close()
+	for tick := 0; replayed.state == GameStart && tick < 100000; tick++ {
+		replayed.step()
+	}
+	assert.Equal(t, played.sim.Data().Score, replayed.sim.Data().Score, "Score should be same")
+	assert.Equal(t, played.sim.Data().Depth(), replayed.sim.Data().Depth(), "Depth should be same")
+
+	files, _ = filepath.Glob(filepath.Join(dir, "*.replay"))
+	assert.Len(t, files, 1, "Replay should not be saved again")
+}
@@ -1,7 +1,7 @@
 package pacman
 
 import (
-	"image/color"
+	"strconv"
 
 	"github.com/golang/freetype/truetype"
 	"golang.org/x/image/font"
@@ -9,110 +9,148 @@ import (
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/text"
 	"github.com/skatiyar/pacman/assets"
+	"github.com/skatiyar/pacman/engine"
 	"github.com/skatiyar/pacman/spritetools"
 )
 
 const GridViewSize = 1024
 
-var GrayColor = color.RGBA{236, 240, 241, 255.0}
-
+// GridView returns a function drawing grid of the game, images
+// it makes are kept in made, if given.
 func GridView(
 	characters *assets.Characters,
 	powers *assets.Powers,
 	arcadeFont *truetype.Font,
-	mazeView func(state gameState, data *Data) (*ebiten.Image, error),
-) (func(state gameState, data *Data) (*ebiten.Image, error), error) {
+	palette *assets.Palette,
+	mazeView func(view *ebiten.Image, data *engine.Data, viewY float64) error,
+	columns int,
+	made *images,
+) (func(state gameState, data *engine.Data) (*ebiten.Image, error), error) {
 	fontface := truetype.NewFace(arcadeFont, &truetype.Options{
 		Size:    32,
 		DPI:     72,
 		Hinting: font.HintingFull,
 	})
 
-	limeAlpha := color.RGBA{250, 233, 8, 200}
+	smallfontface := truetype.NewFace(arcadeFont, &truetype.Options{
+		Size:    16,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
 
-	dot, dotErr := ebiten.NewImage(8, 8, ebiten.FilterDefault)
+	dot, dotErr := made.keep(ebiten.NewImage(8, 8, ebiten.FilterDefault))
 	if dotErr != nil {
 		return nil, dotErr
 	}
-	if fillErr := dot.Fill(limeAlpha); fillErr != nil {
+	if fillErr := dot.Fill(palette.Dot); fillErr != nil {
 		return nil, fillErr
 	}
 
-	pacman, pacmanErr := spritetools.ScaleSprite(characters.Pacman, 0.5, 0.5)
-	if pacmanErr != nil {
-		return nil, pacmanErr
+	chomp, chompErr := made.keepAnimation(spritetools.NewAnimation(pingPong(characters.PacmanChomp), 12, spritetools.Loop).Scale(0.5, 0.5))
+	if chompErr != nil {
+		return nil, chompErr
 	}
 
-	ghost1, ghost1Err := spritetools.ScaleSprite(characters.Ghost1, 0.5, 0.5)
-	if ghost1Err != nil {
-		return nil, ghost1Err
+	death, deathErr := made.keepAnimation(spritetools.NewAnimation(characters.PacmanDeath, 10, spritetools.Once).Scale(0.5, 0.5))
+	if deathErr != nil {
+		return nil, deathErr
 	}
 
-	ghost2, ghost2Err := spritetools.ScaleSprite(characters.Ghost2, 0.5, 0.5)
-	if ghost2Err != nil {
-		return nil, ghost2Err
+	var wobble [4]*spritetools.Animation
+	for i := 0; i < len(wobble); i++ {
+		ghost, ghostErr := made.keepAnimation(spritetools.NewAnimation(characters.GhostWobble[i], 6, spritetools.Loop).Scale(0.5, 0.5))
+		if ghostErr != nil {
+			return nil, ghostErr
+		}
+		wobble[i] = ghost
 	}
 
-	ghost3, ghost3Err := spritetools.ScaleSprite(characters.Ghost3, 0.5, 0.5)
-	if ghost3Err != nil {
-		return nil, ghost3Err
+	frightened, frightenedErr := made.keepAnimation(spritetools.NewAnimation(characters.Frightened, 6, spritetools.Loop).Scale(0.5, 0.5))
+	if frightenedErr != nil {
+		return nil, frightenedErr
 	}
 
-	ghost4, ghost4Err := spritetools.ScaleSprite(characters.Ghost4, 0.5, 0.5)
-	if ghost4Err != nil {
-		return nil, ghost4Err
+	blinking, blinkingErr := made.keepAnimation(spritetools.NewAnimation(characters.Blinking, 6, spritetools.Loop).Scale(0.5, 0.5))
+	if blinkingErr != nil {
+		return nil, blinkingErr
 	}
 
-	life, lifeErr := spritetools.ScaleSprite(powers.Life, 0.5, 0.5)
+	life, lifeErr := made.keep(spritetools.ScaleSprite(powers.Life, 0.5, 0.5))
 	if lifeErr != nil {
 		return nil, lifeErr
 	}
 
-	invinci, invinciErr := spritetools.ScaleSprite(powers.Invincibility, 0.5, 0.5)
+	invinci, invinciErr := made.keep(spritetools.ScaleSprite(powers.Invincibility, 0.5, 0.5))
 	if invinciErr != nil {
 		return nil, invinciErr
 	}
 
-	view, viewErr := ebiten.NewImage(64*Columns, GridViewSize, ebiten.FilterDefault)
+	view, viewErr := made.keep(ebiten.NewImage(CellSize*columns, GridViewSize, ebiten.FilterDefault))
 	if viewErr != nil {
 		return nil, viewErr
 	}
 
-	return func(state gameState, data *Data) (*ebiten.Image, error) {
+	// texts & boxes are centred on the grid.
+	center := CellSize * columns / 2
+
+	// clocks of animations count frames of a running game, so
+	// animations hold still while paused. Pacman chomps only
+	// while moving, death plays where pacman lost a life, on
+	// a clock of its own which goes on once game is over.
+	frames, chomping := 0, 0
+	lifes, dying := 0, -1
+	var pacmanX, pacmanY, deathX, deathY float64
+
+	return func(state gameState, data *engine.Data) (*ebiten.Image, error) {
 		if clearErr := view.Clear(); clearErr != nil {
 			return nil, clearErr
 		}
-		if fillErr := view.Fill(color.Black); fillErr != nil {
+		if fillErr := view.Fill(palette.Background); fillErr != nil {
 			return nil, fillErr
 		}
 
 		ops := &ebiten.DrawImageOptions{}
 		switch state {
 		case GameLoading:
-			text.Draw(view, "PRESS SPACE", fontface, 320-176, 512-(10+32), color.White)
-			text.Draw(view, "TO BEGIN", fontface, 320-128, 512+(10), color.White)
+			// next run starts afresh.
+			lifes, dying, chomping = 0, -1, 0
+			text.Draw(view, "PRESS SPACE", fontface, center-176, 512-(10+32), palette.Text)
+			text.Draw(view, "TO BEGIN", fontface, center-128, 512+(10), palette.Text)
 		case GameStart, GamePause, GameOver:
-			mazeView, mazeViewErr := mazeView(state, data)
-			if mazeViewErr != nil {
-				return nil, mazeViewErr
+			// positions are in world coordinates,
+			// dots are drawn from world row of grid.
+			viewY := GridViewSize + data.ViewY
+			gridY := float64(data.Row * CellSize)
+
+			if state == GameStart {
+				frames += 1
+				if data.Pacman.PosX != pacmanX || data.Pacman.PosY != pacmanY {
+					chomping += 1
+				}
+			}
+			if dying >= 0 && state != GamePause {
+				dying += 1
+			}
+			if data.Lifes < lifes {
+				dying, deathX, deathY = 0, pacmanX, pacmanY
+			}
+			lifes, pacmanX, pacmanY = data.Lifes, data.Pacman.PosX, data.Pacman.PosY
+			seconds := func(frames int) float64 {
+				return float64(frames) / engine.TicksPerSecond
 			}
 
-			ops.GeoM.Reset()
-			ops.GeoM.Translate(0,
-				-(float64(len(data.grid)*CellSize) - (GridViewSize + data.gridOffsetY)))
-			if drawErr := view.DrawImage(mazeView, ops); drawErr != nil {
+			if drawErr := mazeView(view, data, viewY); drawErr != nil {
 				return nil, drawErr
 			}
 
-			for i := 0; i < len(data.active); i++ {
-				for j := 0; j < Columns; j++ {
-					if !data.active[i][j] {
+			for i := 0; i < len(data.Active); i++ {
+				for j := 0; j < data.Columns; j++ {
+					if !data.Active[i][j] {
 						ops.GeoM.Reset()
 						ops.GeoM.Translate(
 							float64((j*CellSize)+30),
-							-(float64(((i*CellSize)+
-								(CellSize/2))+2) -
-								(GridViewSize + data.gridOffsetY)))
+							-(gridY + float64(((i*CellSize)+
+								(CellSize/2))+2) - viewY))
 						if drawErr := view.DrawImage(dot, ops); drawErr != nil {
 							return nil, drawErr
 						}
@@ -120,106 +158,129 @@ func GridView(
 				}
 			}
 
-			for i := 0; i < len(data.powers); i++ {
-				power := data.powers[i]
+			for i := 0; i < len(data.Powers); i++ {
+				power := data.Powers[i]
 				powerImg := life
-				if power.kind == Invincibility {
+				if power.Kind == engine.Invincibility {
 					powerImg = invinci
 				}
 				pwidth, pheight := powerImg.Size()
 				ops.GeoM.Reset()
 				ops.GeoM.Translate(
-					float64((data.powers[i].cellX*CellSize)+pwidth/2),
-					-(float64(((data.powers[i].cellY*CellSize)+
-						(CellSize/2))+pheight/2) - (GridViewSize + data.gridOffsetY)))
+					float64((data.Powers[i].CellX*CellSize)+pwidth/2),
+					-(float64(((data.Powers[i].CellY*CellSize)+
+						(CellSize/2))+pheight/2) - viewY))
 				if drawErr := view.DrawImage(powerImg, ops); drawErr != nil {
 					return nil, drawErr
 				}
 			}
 
+			if dying >= 0 {
+				deathImg := death.Frame(seconds(dying))
+				dwidth, dheight := deathImg.Size()
+				ops.GeoM.Reset()
+				ops.GeoM.Translate(
+					deathX-float64(dwidth/2),
+					viewY-(deathY+float64(dheight/2)))
+				if drawErr := view.DrawImage(deathImg, ops); drawErr != nil {
+					return nil, drawErr
+				}
+				if death.Done(seconds(dying)) && state != GameOver {
+					dying = -1
+				}
+			}
+
+			pacman := chomp.Frame(seconds(chomping))
 			ops.GeoM.Reset()
 			pwidth, pheight := pacman.Size()
-			switch data.pacman.direction {
-			case North:
+			switch data.Pacman.Direction {
+			case engine.North:
 				ops.GeoM.Rotate(-1.5708)
 				ops.GeoM.Translate(
-					data.pacman.posX-float64(pwidth/2),
-					GridViewSize-(data.pacman.posY-float64(pheight-(pheight/2))))
-			case East:
+					data.Pacman.PosX-float64(pwidth/2),
+					viewY-(data.Pacman.PosY-float64(pheight-(pheight/2))))
+			case engine.East:
 				ops.GeoM.Translate(
-					data.pacman.posX-float64(pwidth/2),
-					GridViewSize-(data.pacman.posY+float64(pheight/2)))
-			case South:
+					data.Pacman.PosX-float64(pwidth/2),
+					viewY-(data.Pacman.PosY+float64(pheight/2)))
+			case engine.South:
 				ops.GeoM.Rotate(1.5708)
 				ops.GeoM.Translate(
-					data.pacman.posX+float64(pwidth/2),
-					GridViewSize-(data.pacman.posY+float64(pheight/2)))
-			case West:
+					data.Pacman.PosX+float64(pwidth/2),
+					viewY-(data.Pacman.PosY+float64(pheight/2)))
+			case engine.West:
 				ops.GeoM.Rotate(3.14159)
 				ops.GeoM.Translate(
-					data.pacman.posX+float64(pwidth/2),
-					GridViewSize-(data.pacman.posY-float64(pheight-(pheight/2))))
+					data.Pacman.PosX+float64(pwidth/2),
+					viewY-(data.Pacman.PosY-float64(pheight-(pheight/2))))
 			}
-			if drawErr := view.DrawImage(pacman, ops); drawErr != nil {
-				return nil, drawErr
+			if data.Lifes > 0 {
+				if drawErr := view.DrawImage(pacman, ops); drawErr != nil {
+					return nil, drawErr
+				}
 			}
 
-			for i := 0; i < len(data.ghosts); i++ {
-				ghost := data.ghosts[i]
-				ghostImg := ghost1
-				switch ghost.kind {
-				case Ghost2:
-					ghostImg = ghost2
-				case Ghost3:
-					ghostImg = ghost3
-				case Ghost4:
-					ghostImg = ghost4
-				}
-				gwidth, gheight := ghostImg.Size()
+			for i := 0; i < len(data.Ghosts); i++ {
+				ghost := data.Ghosts[i]
+				ghostImg := wobble[ghost.Kind].Frame(seconds(frames))
 				ops.GeoM.Reset()
-				if data.invincible {
-					ops.ColorM.ChangeHSV(0, 0, 1)
+				ops.ColorM.Reset()
+				switch ghost.Mode {
+				case engine.FrightenedMode:
+					// blue, blinking white before invincibility ends
+					ghostImg = frightened.Frame(seconds(frames))
+					remaining := data.Invincible.Remaining()
+					if remaining < engine.FrightenedBlinkTicks &&
+						(remaining/(engine.TicksPerSecond/4))%2 == 0 {
+						ghostImg = blinking.Frame(seconds(frames))
+					}
+				case engine.EyesMode:
+					ops.ColorM.Scale(1, 1, 1, 0.3)
 				}
+				gwidth, gheight := ghostImg.Size()
 				ops.GeoM.Translate(
-					data.ghosts[i].posX-float64(gwidth/2),
-					(GridViewSize+data.gridOffsetY)-
-						(data.ghosts[i].posY+float64(gheight-(gheight/2))))
+					data.Ghosts[i].PosX-float64(gwidth/2),
+					viewY-
+						(data.Ghosts[i].PosY+float64(gheight-(gheight/2))))
 				if drawErr := view.DrawImage(ghostImg, ops); drawErr != nil {
 					return nil, drawErr
 				}
 			}
+			ops.ColorM.Reset()
 
 			if state == GamePause {
 				back, backErr := ebiten.NewImage(389, 130, ebiten.FilterDefault)
 				if backErr != nil {
 					return nil, backErr
 				}
-				if fillErr := back.Fill(color.Black); fillErr != nil {
+				if fillErr := back.Fill(palette.Background); fillErr != nil {
 					return nil, fillErr
 				}
 
-				text.Draw(back, "GAME PAUSED", fontface, 24, 65-(10), color.White)
-				text.Draw(back, "PRESS SPACE", fontface, 24, 65+(10+31), color.White)
+				text.Draw(back, "GAME PAUSED", fontface, 24, 65-(10), palette.Text)
+				text.Draw(back, "PRESS SPACE", fontface, 24, 65+(10+31), palette.Text)
 
 				ops.GeoM.Reset()
-				ops.GeoM.Translate(320-(389/2), 512-(130/2))
+				ops.GeoM.Translate(float64(center-(389/2)), 512-(130/2))
 				if drawErr := view.DrawImage(back, ops); drawErr != nil {
 					return nil, drawErr
 				}
 			} else if state == GameOver {
-				back, backErr := ebiten.NewImage(389, 130, ebiten.FilterDefault)
+				back, backErr := ebiten.NewImage(389, 170, ebiten.FilterDefault)
 				if backErr != nil {
 					return nil, backErr
 				}
-				if fillErr := back.Fill(color.Black); fillErr != nil {
+				if fillErr := back.Fill(palette.Background); fillErr != nil {
 					return nil, fillErr
 				}
 
-				text.Draw(back, "GAME OVER", fontface, 56, 65-(10), color.White)
-				text.Draw(back, "PRESS SPACE", fontface, 24, 65+(10+31), color.White)
+				seed := "SEED " + strconv.FormatInt(data.Seed, 10)
+				text.Draw(back, "GAME OVER", fontface, 56, 65-(10), palette.Text)
+				text.Draw(back, "PRESS SPACE", fontface, 24, 65+(10+31), palette.Text)
+				text.Draw(back, seed, smallfontface, 194-(len(seed)*8), 65+(10+31)+40, palette.Dim)
 
 				ops.GeoM.Reset()
-				ops.GeoM.Translate(320-(389/2), 512-(130/2))
+				ops.GeoM.Translate(float64(center-(389/2)), 512-(170/2))
 				if drawErr := view.DrawImage(back, ops); drawErr != nil {
 					return nil, drawErr
 				}
@@ -229,3 +290,16 @@ func GridView(
 		return view, nil
 	}, nil
 }
+
+// pingPong returns frames followed by the ones between last & first
+// in reverse, so mouth opens back after closing, chomp goes wide,
+// half, closed, half & starts over. Themes may have any number of
+// chomp frames, one or two are played as given.
+func pingPong(frames []*ebiten.Image) []*ebiten.Image {
+	played := make([]*ebiten.Image, 0, 2*len(frames))
+	played = append(played, frames...)
+	for i := len(frames) - 2; i > 0; i-- {
+		played = append(played, frames[i])
+	}
+	return played
+}
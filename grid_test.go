@@ -0,0 +1,28 @@
+package pacman
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPingPong(t *testing.T) {
+	// frames are told apart by pointer, zero images are all equal.
+	wide, half, closed := &ebiten.Image{}, &ebiten.Image{}, &ebiten.Image{}
+	played := pingPong([]*ebiten.Image{wide, half, closed})
+	if assert.Len(t, played, 4, "Should open mouth back") {
+		assert.True(t, played[0] == wide && played[1] == half &&
+			played[2] == closed && played[3] == half, "Should go wide, half, closed, half")
+	}
+
+	played = pingPong([]*ebiten.Image{wide, closed})
+	if assert.Len(t, played, 2, "Should play two frames as given") {
+		assert.True(t, played[0] == wide && played[1] == closed, "Should keep order")
+	}
+
+	played = pingPong([]*ebiten.Image{wide})
+	if assert.Len(t, played, 1, "Should play single frame as given") {
+		assert.True(t, played[0] == wide, "Should keep frame")
+	}
+}
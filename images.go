@@ -0,0 +1,40 @@
+package pacman
+
+import (
+	"github.com/hajimehoshi/ebiten"
+	"github.com/skatiyar/pacman/spritetools"
+)
+
+// images collects images made for views, so they can be
+// disposed of together, once views are replaced or fail to
+// load. A nil collection keeps nothing.
+type images []*ebiten.Image
+
+// keep adds given image to collection, passing along
+// results of the call which made it.
+func (i *images) keep(image *ebiten.Image, err error) (*ebiten.Image, error) {
+	if i != nil && image != nil {
+		*i = append(*i, image)
+	}
+	return image, err
+}
+
+// keepAnimation adds frames of given animation to collection,
+// passing along results of the call which made it.
+func (i *images) keepAnimation(animation *spritetools.Animation, err error) (*spritetools.Animation, error) {
+	if i != nil && animation != nil {
+		*i = append(*i, animation.Frames...)
+	}
+	return animation, err
+}
+
+// dispose disposes of every image kept & empties collection.
+func (i *images) dispose() {
+	if i == nil {
+		return
+	}
+	for _, image := range *i {
+		image.Dispose()
+	}
+	*i = nil
+}
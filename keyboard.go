@@ -3,6 +3,7 @@ package pacman
 import (
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/skatiyar/pacman/engine"
 )
 
 func spacePressed() bool {
@@ -13,6 +14,12 @@ func spaceReleased() bool {
 	return inpututil.IsKeyJustReleased(ebiten.KeySpace)
 }
 
+// fullscreenReleased reports whether F key, which
+// toggles fullscreen, was just released.
+func fullscreenReleased() bool {
+	return inpututil.IsKeyJustReleased(ebiten.KeyF)
+}
+
 func upKeyPressed() bool {
 	return inpututil.IsKeyJustPressed(ebiten.KeyUp)
 }
@@ -44,3 +51,14 @@ func rightKeyPressed() bool {
 func rightKeyReleased() bool {
 	return inpututil.IsKeyJustReleased(ebiten.KeyRight)
 }
+
+// keyboardInput returns the direction keys pressed
+// in current frame, as input for the simulation.
+func keyboardInput() engine.Input {
+	return engine.Input{
+		Up:    upKeyPressed(),
+		Down:  downKeyPressed(),
+		Left:  leftKeyPressed(),
+		Right: rightKeyPressed(),
+	}
+}
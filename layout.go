@@ -0,0 +1,85 @@
+package pacman
+
+import (
+	"image"
+	"math"
+)
+
+const (
+	// hudHeight is height of the header of skin, logo,
+	// score, lifes & the power bar just below them.
+	hudHeight = skinHeaderHeight + 4
+	// gridX & gridY place grid inside skin.
+	gridX = 38
+	gridY = 162
+	// windowScale scales screen down to window, art is drawn
+	// at twice its size, for good rendering on retina.
+	windowScale = 0.5
+)
+
+// Layout places skin, hud & grid on a screen. Game is laid out
+// at size of its art, then scaled by a whole number, or divided
+// by one when screen is smaller, to keep pixels of art sharp.
+type Layout struct {
+	// Scale is size of a pixel of art, on screen.
+	Scale float64
+	// Landscape puts hud to the left of frame,
+	// instead of atop it.
+	Landscape bool
+	// Hud, Frame & Grid are where top left corners of
+	// header of skin, rest of skin & grid go on screen.
+	Hud, Frame, Grid image.Point
+}
+
+// NewLayout returns layout of a skin of given size, on a screen
+// of given size. Hud goes beside frame on screens wider than
+// tall, unless it makes the game smaller.
+func NewLayout(skinWidth, skinHeight, screenWidth, screenHeight int) Layout {
+	frameHeight := skinHeight - hudHeight
+
+	layout := Layout{Scale: fitScale(skinWidth, skinHeight, screenWidth, screenHeight)}
+	width, height := skinWidth, skinHeight
+	hud, frame := image.Pt(0, 0), image.Pt(0, hudHeight)
+	if screenWidth > screenHeight {
+		landscapeHeight := frameHeight
+		if landscapeHeight < hudHeight {
+			landscapeHeight = hudHeight
+		}
+		scale := fitScale(2*skinWidth, landscapeHeight, screenWidth, screenHeight)
+		if scale >= layout.Scale {
+			layout.Scale, layout.Landscape = scale, true
+			width, height = 2*skinWidth, landscapeHeight
+			frame = image.Pt(skinWidth, 0)
+		}
+	}
+
+	// game is centred on screen.
+	left := (screenWidth - int(float64(width)*layout.Scale)) / 2
+	top := (screenHeight - int(float64(height)*layout.Scale)) / 2
+	place := func(p image.Point) image.Point {
+		return image.Pt(
+			left+int(float64(p.X)*layout.Scale),
+			top+int(float64(p.Y)*layout.Scale))
+	}
+	layout.Hud = place(hud)
+	layout.Frame = place(frame)
+	layout.Grid = place(frame.Add(image.Pt(gridX, gridY-hudHeight)))
+	return layout
+}
+
+// fitScale returns the largest whole number a game of given size
+// can be scaled by on screen, or 1/n for the smallest n it can
+// be divided by, when it is larger than screen.
+func fitScale(width, height, screenWidth, screenHeight int) float64 {
+	scale := screenWidth / width
+	if s := screenHeight / height; s < scale {
+		scale = s
+	}
+	if scale >= 1 {
+		return float64(scale)
+	}
+	divisor := math.Max(
+		math.Ceil(float64(width)/float64(screenWidth)),
+		math.Ceil(float64(height)/float64(screenHeight)))
+	return 1 / divisor
+}
@@ -0,0 +1,31 @@
+package pacman
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLayout(t *testing.T) {
+	// default window fits the skin.
+	layout := NewLayout(712, 1220, 712, 1220)
+	assert.Equal(t, 1.0, layout.Scale, "Scale should be same")
+	assert.False(t, layout.Landscape, "Should be portrait")
+	assert.Equal(t, image.Pt(0, 0), layout.Hud, "Hud should be atop")
+	assert.Equal(t, image.Pt(38, 162), layout.Grid, "Grid should be inside skin")
+
+	layout = NewLayout(712, 1220, 1600, 2600)
+	assert.Equal(t, 2.0, layout.Scale, "Should scale by whole number")
+	assert.Equal(t, image.Pt(88+76, 80+324), layout.Grid, "Game should be centred")
+
+	layout = NewLayout(712, 1220, 2560, 1440)
+	assert.True(t, layout.Landscape, "Hud should go beside frame")
+	assert.Equal(t, 1.0, layout.Scale, "Scale should be same")
+	assert.Equal(t, image.Pt(568, 172), layout.Hud, "Hud should be left of frame")
+	assert.Equal(t, image.Pt(568+712, 172), layout.Frame, "Frame should be right of hud")
+	assert.Equal(t, image.Pt(568+712+38, 172+38), layout.Grid, "Grid should be inside frame")
+
+	layout = NewLayout(712, 1220, 500, 700)
+	assert.Equal(t, 0.5, layout.Scale, "Should divide by whole number")
+}
@@ -1,151 +1,225 @@
 package pacman
 
 import (
+	"math"
+
 	"github.com/hajimehoshi/ebiten"
 	"github.com/skatiyar/pacman/assets"
+	"github.com/skatiyar/pacman/engine"
 	"github.com/skatiyar/pacman/spritetools"
 )
 
-const MazeViewSize = 1536
-const CellSize = 64
+const MazeViewSize = engine.MazeViewSize
+const CellSize = engine.CellSize
+
+// exploredFade is height over which walls of explored
+// cells fade out, towards bottom edge of view.
+const exploredFade = 4 * CellSize
+
+// explored masks hold a bit per column, this fails to
+// compile once rows can be wider than a mask.
+const _ uint32 = 1 << (engine.MaxColumns - 1)
+
+// rowTile is walls of a single row of maze, drawn once at
+// given version of the row. Active holds walls of explored
+// cells, drawn with active sprites on top of walls.
+type rowTile struct {
+	version  int
+	explored uint32
+	image    *ebiten.Image
+	active   *ebiten.Image
+}
 
+// MazeView returns a function drawing walls of maze on a view,
+// which shows maze from world position viewY upwards. Every row
+// is drawn once into a tile of its own, tiles are redrawn only
+// when version of their row changes & reused once scrolled off.
+//
+// Walls around cells pacman has explored light up, with active
+// sprites, fading as rows near bottom edge of view. Images it
+// makes are kept in made, if given.
 func MazeView(
 	walls *assets.Walls,
-) (func(state gameState, data *Data) (*ebiten.Image, error), error) {
-	icWallSide, icWallSideErr := spritetools.ScaleSprite(walls.InActiveSide, 1.0, 1.0)
+	columns int,
+	made *images,
+) (func(view *ebiten.Image, data *engine.Data, viewY float64) error, error) {
+	icWallSide, icWallSideErr := made.keep(spritetools.ScaleSprite(walls.InActiveSide, 1.0, 1.0))
 	if icWallSideErr != nil {
 		return nil, icWallSideErr
 	}
 
-	icWallCorner, icWallCornerErr := spritetools.ScaleSprite(walls.InActiveCorner, 1.0, 1.0)
+	icWallCorner, icWallCornerErr := made.keep(spritetools.ScaleSprite(walls.InActiveCorner, 1.0, 1.0))
 	if icWallCornerErr != nil {
 		return nil, icWallCornerErr
 	}
 
-	mazeView, mazeViewErr := ebiten.NewImage(CellSize*Columns, MazeViewSize, ebiten.FilterDefault)
-	if mazeViewErr != nil {
-		return nil, mazeViewErr
+	acWallSide, acWallSideErr := made.keep(spritetools.ScaleSprite(walls.ActiveSide, 1.0, 1.0))
+	if acWallSideErr != nil {
+		return nil, acWallSideErr
 	}
 
-	var lastGrid [][Columns][4]rune
+	acWallCorner, acWallCornerErr := made.keep(spritetools.ScaleSprite(walls.ActiveCorner, 1.0, 1.0))
+	if acWallCornerErr != nil {
+		return nil, acWallCornerErr
+	}
 
-	return func(state gameState, data *Data) (*ebiten.Image, error) {
-		if equal, copy := deepEqual(lastGrid, data.grid); equal {
-			return mazeView, nil
-		} else {
-			lastGrid = copy
+	// tiles are keyed by world row.
+	tiles := make(map[int]*rowTile)
+	free := make([]*ebiten.Image, 0)
+	ops := &ebiten.DrawImageOptions{}
+
+	// tileImage returns an image of size of a row,
+	// reusing images of tiles scrolled off.
+	tileImage := func() (*ebiten.Image, error) {
+		if len(free) > 0 {
+			image := free[len(free)-1]
+			free = free[:len(free)-1]
+			return image, nil
 		}
+		return made.keep(ebiten.NewImage(CellSize*columns, CellSize, ebiten.FilterDefault))
+	}
 
-		if clearErr := mazeView.Clear(); clearErr != nil {
-			return nil, clearErr
+	return func(view *ebiten.Image, data *engine.Data, viewY float64) error {
+		for row, tile := range tiles {
+			if row < data.Row || row >= data.Row+len(data.Grid) {
+				free = append(free, tile.image, tile.active)
+				delete(tiles, row)
+			}
 		}
 
-		ops := &ebiten.DrawImageOptions{}
-
-		for i := 0; i < len(data.grid); i++ {
-			for j := 0; j < len(data.grid[i]); j++ {
-				side := icWallSide
-				corner := icWallCorner
-
-				cellWalls := data.grid[i][j]
-				if cellWalls[0] == 'N' {
-					ops.GeoM.Reset()
-					ops.GeoM.Translate(float64(j*CellSize)+12,
-						float64(MazeViewSize-((i*CellSize)+CellSize)))
-					if drawErr := mazeView.DrawImage(side, ops); drawErr != nil {
-						return nil, drawErr
-					}
-				}
-				if cellWalls[1] == 'E' {
-					ops.GeoM.Reset()
-					ops.GeoM.Rotate(1.5708)
-					ops.GeoM.Translate(float64(j*CellSize)+CellSize,
-						float64(MazeViewSize-((i*CellSize)+52)))
-					if drawErr := mazeView.DrawImage(side, ops); drawErr != nil {
-						return nil, drawErr
-					}
+		for i := 0; i < len(data.Grid); i++ {
+			row := data.Row + i
+			tile, ok := tiles[row]
+			if !ok {
+				image, imageErr := tileImage()
+				if imageErr != nil {
+					return imageErr
 				}
-				if cellWalls[2] == 'S' {
-					ops.GeoM.Reset()
-					ops.GeoM.Translate(float64(j*CellSize)+12,
-						float64(MazeViewSize-((i*CellSize)+12)))
-					if drawErr := mazeView.DrawImage(side, ops); drawErr != nil {
-						return nil, drawErr
-					}
-				}
-				if cellWalls[3] == 'W' {
-					ops.GeoM.Reset()
-					ops.GeoM.Rotate(1.5708)
-					ops.GeoM.Translate(float64(j*CellSize)+12,
-						float64(MazeViewSize-((i*CellSize)+52)))
-					if drawErr := mazeView.DrawImage(side, ops); drawErr != nil {
-						return nil, drawErr
-					}
+				active, activeErr := tileImage()
+				if activeErr != nil {
+					return activeErr
 				}
+				tile = &rowTile{version: -1, image: image, active: active}
+				tiles[row] = tile
+			}
 
-				// Corners NE
-				ops.GeoM.Reset()
-				ops.GeoM.Translate(float64(j*CellSize)+52,
-					float64(MazeViewSize-((i*CellSize)+CellSize)))
-				if drawErr := mazeView.DrawImage(corner, ops); drawErr != nil {
-					return nil, drawErr
+			explored := uint32(0)
+			for j := 0; j < len(data.Active[i]); j++ {
+				if data.Active[i][j] {
+					explored |= 1 << uint(j)
 				}
-
-				// NW
-				ops.GeoM.Reset()
-				ops.GeoM.Translate(float64(j*CellSize),
-					float64(MazeViewSize-((i*CellSize)+CellSize)))
-				if drawErr := mazeView.DrawImage(corner, ops); drawErr != nil {
-					return nil, drawErr
+			}
+			if tile.version != data.RowVersions[i] {
+				if drawErr := drawRow(tile.image, data.Grid[i], ^uint32(0), icWallSide, icWallCorner, ops); drawErr != nil {
+					return drawErr
 				}
-
-				// SE
-				ops.GeoM.Reset()
-				ops.GeoM.Translate(float64(j*CellSize)+52,
-					float64(MazeViewSize-((i*CellSize)+12)))
-				if drawErr := mazeView.DrawImage(corner, ops); drawErr != nil {
-					return nil, drawErr
+			}
+			if tile.version != data.RowVersions[i] || tile.explored != explored {
+				if drawErr := drawRow(tile.active, data.Grid[i], explored, acWallSide, acWallCorner, ops); drawErr != nil {
+					return drawErr
 				}
+			}
+			tile.version, tile.explored = data.RowVersions[i], explored
+
+			ops.GeoM.Reset()
+			ops.GeoM.Translate(0, viewY-float64((row*CellSize)+CellSize))
+			if drawErr := view.DrawImage(tile.image, ops); drawErr != nil {
+				return drawErr
+			}
 
-				// SW
-				ops.GeoM.Reset()
-				ops.GeoM.Translate(float64(j*CellSize),
-					float64(MazeViewSize-((i*CellSize)+12)))
-				if drawErr := mazeView.DrawImage(corner, ops); drawErr != nil {
-					return nil, drawErr
+			// distance of bottom of row from bottom of view.
+			above := float64(GridViewSize) - (viewY - float64(row*CellSize))
+			if explored != 0 && above > 0 {
+				ops.ColorM.Reset()
+				ops.ColorM.Scale(1, 1, 1, math.Min(above/exploredFade, 1))
+				if drawErr := view.DrawImage(tile.active, ops); drawErr != nil {
+					return drawErr
 				}
+				ops.ColorM.Reset()
 			}
 		}
 
-		return mazeView, nil
+		return nil
 	}, nil
 }
 
-func deepEqual(previous, next [][Columns][4]rune) (bool, [][Columns][4]rune) {
-	deepCopy := func(src [][Columns][4]rune) [][Columns][4]rune {
-		copy := make([][Columns][4]rune, 0)
-		for i := 0; i < len(next); i++ {
-			row := [Columns][4]rune{}
-			for j := 0; j < Columns; j++ {
-				row[j] = next[i][j]
+// drawRow draws walls of cells of given row into tile,
+// only cells with their bit set in given mask are drawn.
+func drawRow(
+	tile *ebiten.Image,
+	cells []engine.Cell,
+	mask uint32,
+	side, corner *ebiten.Image,
+	ops *ebiten.DrawImageOptions,
+) error {
+	if clearErr := tile.Clear(); clearErr != nil {
+		return clearErr
+	}
+
+	for j := 0; j < len(cells); j++ {
+		if mask&(1<<uint(j)) == 0 {
+			continue
+		}
+		cell := cells[j]
+		if cell.HasWall(engine.North) {
+			ops.GeoM.Reset()
+			ops.GeoM.Translate(float64(j*CellSize)+12, 0)
+			if drawErr := tile.DrawImage(side, ops); drawErr != nil {
+				return drawErr
 			}
-			copy = append(copy, row)
 		}
-		return copy
-	}
-	if len(previous) != len(next) {
-		return false, deepCopy(next)
-	}
-	for i := 0; i < len(previous); i++ {
-		if previous[i] != next[i] {
-			return false, deepCopy(next)
+		if cell.HasWall(engine.East) {
+			ops.GeoM.Reset()
+			ops.GeoM.Rotate(1.5708)
+			ops.GeoM.Translate(float64(j*CellSize)+CellSize, CellSize-52)
+			if drawErr := tile.DrawImage(side, ops); drawErr != nil {
+				return drawErr
+			}
+		}
+		if cell.HasWall(engine.South) {
+			ops.GeoM.Reset()
+			ops.GeoM.Translate(float64(j*CellSize)+12, CellSize-12)
+			if drawErr := tile.DrawImage(side, ops); drawErr != nil {
+				return drawErr
+			}
 		}
-		for j := 0; j < Columns; j++ {
-			if previous[i][j] != next[i][j] {
-				return false, deepCopy(next)
+		if cell.HasWall(engine.West) {
+			ops.GeoM.Reset()
+			ops.GeoM.Rotate(1.5708)
+			ops.GeoM.Translate(float64(j*CellSize)+12, CellSize-52)
+			if drawErr := tile.DrawImage(side, ops); drawErr != nil {
+				return drawErr
 			}
 		}
+
+		// Corners NE
+		ops.GeoM.Reset()
+		ops.GeoM.Translate(float64(j*CellSize)+52, 0)
+		if drawErr := tile.DrawImage(corner, ops); drawErr != nil {
+			return drawErr
+		}
+
+		// NW
+		ops.GeoM.Reset()
+		ops.GeoM.Translate(float64(j*CellSize), 0)
+		if drawErr := tile.DrawImage(corner, ops); drawErr != nil {
+			return drawErr
+		}
+
+		// SE
+		ops.GeoM.Reset()
+		ops.GeoM.Translate(float64(j*CellSize)+52, CellSize-12)
+		if drawErr := tile.DrawImage(corner, ops); drawErr != nil {
+			return drawErr
+		}
+
+		// SW
+		ops.GeoM.Reset()
+		ops.GeoM.Translate(float64(j*CellSize), CellSize-12)
+		if drawErr := tile.DrawImage(corner, ops); drawErr != nil {
+			return drawErr
+		}
 	}
 
-	return true, next
+	return nil
 }
@@ -0,0 +1,83 @@
+//go:build gpu
+// +build gpu
+
+// Benchmarks of drawing need a window & a GPU, they run with
+// go test -tags gpu -run NONE -bench MazeView
+// and are left out of plain, headless test runs. Finding rows to
+// redraw needs no GPU, it is compared against the deepEqual baseline
+// in engine, go test -run NONE -bench Redraw ./engine
+
+package pacman
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/skatiyar/pacman/assets"
+	"github.com/skatiyar/pacman/engine"
+)
+
+// TestMain runs benchmarks inside game loop,
+// images can not be drawn outside it.
+func TestMain(m *testing.M) {
+	code := 0
+	done := errors.New("done")
+	run := func(screen *ebiten.Image) error {
+		code = m.Run()
+		return done
+	}
+	if runErr := ebiten.Run(run, 320, 240, 1, "Test"); runErr != nil && runErr != done {
+		panic(runErr)
+	}
+	os.Exit(code)
+}
+
+func benchmarkMazeView(b *testing.B, input func(tick int) engine.Input) {
+	side, _ := ebiten.NewImage(40, 12, ebiten.FilterDefault)
+	corner, _ := ebiten.NewImage(12, 12, ebiten.FilterDefault)
+	walls := &assets.Walls{
+		ActiveSide:     side,
+		ActiveCorner:   corner,
+		InActiveSide:   side,
+		InActiveCorner: corner,
+	}
+	mazeView, mazeViewErr := MazeView(walls, engine.DefaultColumns, nil)
+	if mazeViewErr != nil {
+		b.Fatal(mazeViewErr)
+	}
+	view, _ := ebiten.NewImage(CellSize*engine.DefaultColumns, GridViewSize, ebiten.FilterDefault)
+
+	rules := engine.DefaultRules()
+	rules.StartLifes = 1000
+	sim := engine.NewSimulation(1, rules)
+	defer sim.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		sim.Step(input(i))
+		b.StartTimer()
+		if drawErr := mazeView(view, sim.Data(), GridViewSize+sim.Data().ViewY); drawErr != nil {
+			b.Fatal(drawErr)
+		}
+	}
+}
+
+// BenchmarkMazeViewStill draws frames of a maze which does not
+// change, only tiles are drawn, none of the rows.
+func BenchmarkMazeViewStill(b *testing.B) {
+	benchmarkMazeView(b, func(tick int) engine.Input {
+		return engine.Input{}
+	})
+}
+
+// BenchmarkMazeViewScroll draws frames of a climbing pacman,
+// rows coming into view are drawn once, as they scroll in.
+func BenchmarkMazeViewScroll(b *testing.B) {
+	benchmarkMazeView(b, func(tick int) engine.Input {
+		return engine.Input{Up: tick%200 < 100, Left: tick%400 < 200, Right: tick%400 >= 200}
+	})
+}
@@ -0,0 +1,106 @@
+package pacman
+
+import (
+	"image"
+
+	"github.com/skatiyar/pacman/engine"
+)
+
+// Option configures a Game created by NewGameWithOptions.
+type Option func(*Game)
+
+// WithSeed makes every run start from given seed, instead
+// of a random one. Same seed always gives the same maze.
+func WithSeed(seed int64) Option {
+	return func(g *Game) {
+		g.seed = seed
+		g.hasSeed = true
+	}
+}
+
+// WithRecording saves a replay of every finished run, as a
+// file in given directory instead of DefaultReplayDir, empty
+// dir saves none.
+func WithRecording(dir string) Option {
+	return func(g *Game) {
+		g.replayDir = dir
+	}
+}
+
+// WithReplay plays back given replay instead of reading
+// the keyboard. Run ends when replay runs out of inputs.
+func WithReplay(replay *engine.Replay) Option {
+	return func(g *Game) {
+		g.playback = replay
+		g.seed = replay.Seed
+		g.hasSeed = true
+	}
+}
+
+// WithGenerator picks algorithm creating the maze by name, one of
+// the keys of engine.Generators. It overrides generator of rules.
+func WithGenerator(name string) Option {
+	return func(g *Game) {
+		g.generator = name
+	}
+}
+
+// WithColumns sets width of the maze in cells, narrow mazes
+// are harder. It overrides columns of rules.
+func WithColumns(columns int) Option {
+	return func(g *Game) {
+		g.columns = columns
+	}
+}
+
+// WithDifficulty makes game harder as player climbs, following
+// given table instead of the one of rules. It overrides
+// difficulty of rules.
+func WithDifficulty(table engine.DifficultyTable) Option {
+	return func(g *Game) {
+		g.difficulty = table
+	}
+}
+
+// WithTheme draws & plays the game with files of theme in
+// given directory, over embedded ones, see assets.ThemeLoader.
+func WithTheme(dir string) Option {
+	return func(g *Game) {
+		g.theme = dir
+	}
+}
+
+// WithWindowSize opens window of given size, in points of the
+// screen, game is scaled to fit it. By default window fits the
+// skin. Player can resize the window later on.
+func WithWindowSize(width, height int) Option {
+	return func(g *Game) {
+		g.window = image.Pt(int(float64(width)/windowScale), int(float64(height)/windowScale))
+	}
+}
+
+// WithFullscreen starts the game in fullscreen,
+// F key switches between fullscreen & window.
+func WithFullscreen() Option {
+	return func(g *Game) {
+		g.fullscreen = true
+	}
+}
+
+// WithRules tunes the game with given rules,
+// instead of engine.DefaultRules.
+func WithRules(rules *engine.Rules) Option {
+	return func(g *Game) {
+		g.rules = rules
+	}
+}
+
+// WithDevMode watches theme directory & given rules file, empty
+// for none, and loads them into running game once they change.
+// Errors of loading are shown over the game, instead of ending it.
+func WithDevMode(rulesFile string) Option {
+	return func(g *Game) {
+		g.dev = true
+		g.rulesFile = rulesFile
+	}
+}
@@ -0,0 +1,209 @@
+package pacman
+
+import (
+	"fmt"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/skatiyar/pacman/assets"
+	"github.com/skatiyar/pacman/engine"
+)
+
+// reloadInterval is how often watched files are checked, in ticks.
+const reloadInterval = engine.TicksPerSecond / 2
+
+// fileStamp tells apart versions of a file.
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// reloader watches files of a theme & a rules file, while
+// developing, by polling their sizes & modification times.
+type reloader struct {
+	theme     string
+	rulesFile string
+	stamps    map[string]fileStamp
+	ticks     int
+	// err is error of last reload, it is shown
+	// over the game until a reload succeeds.
+	err error
+	// overlay shows err, it is drawn once for
+	// every error & width of screen.
+	overlay      *ebiten.Image
+	overlayWidth int
+}
+
+func newReloader(theme, rulesFile string) *reloader {
+	r := &reloader{
+		theme:     theme,
+		rulesFile: rulesFile,
+	}
+	r.stamps = r.scan()
+	return r
+}
+
+// scan returns stamps of watched files, files
+// which can not be read are left out.
+func (r *reloader) scan() map[string]fileStamp {
+	stamps := make(map[string]fileStamp)
+	if r.theme != "" {
+		infos, _ := ioutil.ReadDir(r.theme)
+		for _, info := range infos {
+			if !info.IsDir() {
+				stamps[filepath.Join(r.theme, info.Name())] = fileStamp{info.Size(), info.ModTime()}
+			}
+		}
+	}
+	if r.rulesFile != "" {
+		if info, infoErr := os.Stat(r.rulesFile); infoErr == nil {
+			stamps[r.rulesFile] = fileStamp{info.Size(), info.ModTime()}
+		}
+	}
+	return stamps
+}
+
+// changed reports whether watched files were changed, added
+// or removed since it last did, files are checked only once
+// every reloadInterval calls.
+func (r *reloader) changed() bool {
+	r.ticks += 1
+	if r.ticks < reloadInterval {
+		return false
+	}
+	r.ticks = 0
+
+	stamps := r.scan()
+	changed := len(stamps) != len(r.stamps)
+	for name, stamp := range stamps {
+		if previous, ok := r.stamps[name]; !ok || previous.size != stamp.size ||
+			!previous.modTime.Equal(stamp.modTime) {
+			changed = true
+		}
+	}
+	r.stamps = stamps
+	return changed
+}
+
+// fail sets error of last reload, nil once it succeeds.
+func (r *reloader) fail(err error) {
+	if r.overlay != nil {
+		r.overlay.Dispose()
+	}
+	r.err, r.overlay = err, nil
+}
+
+// reloadFiles loads theme & rules file again, into running game.
+// Run in progress goes on, with new rules, sprites & sounds.
+// Everything is loaded before any of it is put in use, so a
+// failed reload leaves the game as it was.
+func (g *Game) reloadFiles() error {
+	rules := g.rules
+	if g.reload.rulesFile != "" {
+		file, fileErr := os.Open(g.reload.rulesFile)
+		if fileErr != nil {
+			return fileErr
+		}
+		loaded, loadedErr := engine.LoadRules(file)
+		file.Close()
+		if loadedErr != nil {
+			return loadedErr
+		}
+		overridden, overriddenErr := g.override(loaded)
+		if overriddenErr != nil {
+			return overriddenErr
+		}
+		rules = overridden
+	}
+	// views & window are sized to the maze.
+	if rules.Columns != g.rules.Columns {
+		return fmt.Errorf("rules: columns can not change while game runs, got %d, want %d",
+			rules.Columns, g.rules.Columns)
+	}
+
+	theme := assets.NewThemeLoader(g.theme)
+	lAssets, assetsErr := theme.LoadAssets()
+	if assetsErr != nil {
+		return assetsErr
+	}
+	views, viewsErr := loadViews(lAssets, rules.Columns)
+	if viewsErr != nil {
+		return viewsErr
+	}
+	players, playersErr := g.audio.LoadPlayers(theme)
+	if playersErr != nil {
+		views.dispose()
+		return playersErr
+	}
+
+	if rules.Fingerprint() != g.rules.Fingerprint() && g.sim != nil {
+		if rulesErr := g.sim.SetRules(rules); rulesErr != nil {
+			views.dispose()
+			players.Close()
+			return rulesErr
+		}
+		g.retuned = true
+	}
+	// views replaced are not drawn again, update
+	// draws with new ones right after reload.
+	replaced := g.views
+	g.rules = rules
+	g.views = views
+	if replaced != nil {
+		replaced.dispose()
+	}
+	g.width, g.height = skinSize(lAssets.Skin, rules.Columns)
+	return g.audio.SetPlayers(players)
+}
+
+// drawError shows error of last reload, if any, atop the screen.
+func (r *reloader) drawError(screen *ebiten.Image, width int) error {
+	if r.err == nil {
+		return nil
+	}
+	if r.overlay == nil || r.overlayWidth != width {
+		overlay, overlayErr := errorOverlay(r.err, width)
+		if overlayErr != nil {
+			return overlayErr
+		}
+		if r.overlay != nil {
+			r.overlay.Dispose()
+		}
+		r.overlay, r.overlayWidth = overlay, width
+	}
+
+	ops := &ebiten.DrawImageOptions{}
+	ops.GeoM.Scale(2, 2)
+	return screen.DrawImage(r.overlay, ops)
+}
+
+// errorOverlay returns image of given error, for a screen of given
+// width. It is drawn with debug font, as font of theme may be broken.
+func errorOverlay(err error, width int) (*ebiten.Image, error) {
+	// debug font is 6x16, drawn at twice its size.
+	columns := (width/2 - 8) / 6
+	lines := make([]string, 0)
+	for _, line := range strings.Split("RELOAD FAILED\n"+err.Error(), "\n") {
+		for len(line) > columns {
+			lines = append(lines, line[:columns])
+			line = line[columns:]
+		}
+		lines = append(lines, line)
+	}
+
+	overlay, overlayErr := ebiten.NewImage(width/2, len(lines)*16+8, ebiten.FilterNearest)
+	if overlayErr != nil {
+		return nil, overlayErr
+	}
+	if fillErr := overlay.Fill(color.RGBA{0, 0, 0, 200}); fillErr != nil {
+		return nil, fillErr
+	}
+	ebitenutil.DebugPrintAt(overlay, strings.Join(lines, "\n"), 4, 4)
+	return overlay, nil
+}
@@ -0,0 +1,39 @@
+package pacman
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloaderChanged(t *testing.T) {
+	dir, dirErr := ioutil.TempDir("", "theme")
+	assert.Nil(t, dirErr, "Should be nil")
+	defer os.RemoveAll(dir)
+	rulesFile := filepath.Join(dir, "rules.json")
+	assert.Nil(t, ioutil.WriteFile(rulesFile, []byte(`{}`), 0644), "Should be nil")
+
+	r := newReloader(dir, rulesFile)
+	poll := func() bool {
+		changed := false
+		for i := 0; i < reloadInterval; i++ {
+			changed = r.changed() || changed
+		}
+		return changed
+	}
+	assert.False(t, poll(), "Nothing should change")
+
+	later := time.Now().Add(time.Minute)
+	assert.Nil(t, os.Chtimes(rulesFile, later, later), "Should be nil")
+	assert.True(t, poll(), "Rules file should change")
+	assert.False(t, poll(), "Change should be reported once")
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "walls.png"), []byte("png"), 0644), "Should be nil")
+	assert.True(t, poll(), "Added file should change theme")
+	assert.Nil(t, os.Remove(filepath.Join(dir, "walls.png")), "Should be nil")
+	assert.True(t, poll(), "Removed file should change theme")
+}
@@ -1,7 +1,8 @@
 package pacman
 
 import (
-	"image/color"
+	"image"
+	"math"
 	"strconv"
 
 	"github.com/golang/freetype/truetype"
@@ -10,35 +11,67 @@ import (
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/text"
 	"github.com/skatiyar/pacman/assets"
+	"github.com/skatiyar/pacman/engine"
 	"github.com/skatiyar/pacman/spritetools"
 )
 
 const MaxScoreView = 999999999
-const MaxLifes = 7
 
+// MaxLifesView is the most lifes skin has room to show.
+const MaxLifesView = 7
+
+const (
+	// skinHeaderHeight is height of the logo strip
+	// atop skin, frame around grid starts below it.
+	skinHeaderHeight = 120
+	// scoreViewWidth is room score takes, on right of header.
+	scoreViewWidth = 273
+)
+
+// SkinView returns a function drawing skin around grid, images
+// it makes are kept in made, if given.
 func SkinView(
 	skin *ebiten.Image,
 	powers *assets.Powers,
 	arcadeFont *truetype.Font,
-) (func(state gameState, data *Data) (*ebiten.Image, error), error) {
+	palette *assets.Palette,
+	columns int,
+	made *images,
+) (func(state gameState, data *engine.Data) (*ebiten.Image, error), error) {
 	fontface := truetype.NewFace(arcadeFont, &truetype.Options{
 		Size:    28,
 		DPI:     72,
 		Hinting: font.HintingFull,
 	})
 
-	width, height := skin.Size()
-	view, viewErr := ebiten.NewImage(width, height, ebiten.FilterDefault)
+	width, height := skinSize(skin, columns)
+	view, viewErr := made.keep(ebiten.NewImage(width, height, ebiten.FilterDefault))
 	if viewErr != nil {
 		return nil, viewErr
 	}
 
-	life, lifeErr := spritetools.ScaleSprite(powers.Life, 0.5, 0.5)
+	skin, logoScale, skinErr := fitSkin(skin, width)
+	if skinErr != nil {
+		return nil, skinErr
+	}
+	made.keep(skin, nil)
+
+	life, lifeErr := made.keep(spritetools.ScaleSprite(powers.Life, 0.5, 0.5))
 	if lifeErr != nil {
 		return nil, lifeErr
 	}
 
-	return func(state gameState, data *Data) (*ebiten.Image, error) {
+	// power bar shows time left on invincibility,
+	// it sits just below the logo.
+	powerBar, powerBarErr := made.keep(ebiten.NewImage(340, 8, ebiten.FilterDefault))
+	if powerBarErr != nil {
+		return nil, powerBarErr
+	}
+	if fillErr := powerBar.Fill(palette.Dim); fillErr != nil {
+		return nil, fillErr
+	}
+
+	return func(state gameState, data *engine.Data) (*ebiten.Image, error) {
 		if clearErr := view.Clear(); clearErr != nil {
 			return nil, clearErr
 		}
@@ -53,31 +86,102 @@ func SkinView(
 			fallthrough
 		case GameOver:
 			if data != nil {
-				score := data.score
-				lifes := data.lifes
+				score := data.Score
+				lifes := data.Lifes
 
 				if score > MaxScoreView {
 					score = MaxScoreView
 				}
 				numstr := strconv.Itoa(score)
-				text.Draw(view, numstr, fontface, 682-(len(numstr)*27), 64, color.White)
+				text.Draw(view, numstr, fontface, width-30-(len(numstr)*27), 64, palette.Text)
 
-				if lifes > MaxLifes {
-					lifes = MaxLifes
+				if lifes > MaxLifesView {
+					lifes = MaxLifesView
 				}
 
 				ops := &ebiten.DrawImageOptions{}
-				width, _ := life.Size()
+				lifeWidth, _ := life.Size()
 				for i := 0; i < lifes; i++ {
 					ops.GeoM.Reset()
-					ops.GeoM.Translate(float64(650-(width*i)), 80)
+					ops.GeoM.Translate(float64(width-62-(lifeWidth*i)), 80)
 					if drawErr := view.DrawImage(life, ops); drawErr != nil {
 						return nil, drawErr
 					}
 				}
+
+				if data.Invincible.Active() {
+					ops.GeoM.Reset()
+					ops.GeoM.Scale(data.Invincible.Fraction()*logoScale, 1)
+					ops.GeoM.Translate(26*logoScale, 116)
+					if drawErr := view.DrawImage(powerBar, ops); drawErr != nil {
+						return nil, drawErr
+					}
+				}
 			}
 		}
 
 		return view, nil
 	}, nil
 }
+
+// skinSize returns size of skin fitted around
+// a grid of given number of columns.
+func skinSize(skin *ebiten.Image, columns int) (int, int) {
+	width, height := skin.Size()
+	return width + CellSize*(columns-engine.DefaultColumns), height
+}
+
+// fitSkin returns skin stretched or squeezed to given width.
+// Frame around grid is cut in halves, which are moved apart &
+// joined by stretching the column between them. Logo is scaled
+// down, when header gets too narrow for it & the score, scale
+// of logo is returned along.
+func fitSkin(skin *ebiten.Image, width int) (*ebiten.Image, float64, error) {
+	skinWidth, height := skin.Size()
+	half := skinWidth / 2
+
+	fitted, fittedErr := ebiten.NewImage(width, height, ebiten.FilterDefault)
+	if fittedErr != nil {
+		return nil, 0, fittedErr
+	}
+
+	// logo keeps its bottom edge, when scaled.
+	scale := math.Min(1, float64(width-scoreViewWidth)/float64(skinWidth-scoreViewWidth))
+	header := image.Rect(0, 0, skinWidth, skinHeaderHeight)
+	ops := &ebiten.DrawImageOptions{}
+	ops.SourceRect = &header
+	ops.GeoM.Scale(scale, scale)
+	ops.GeoM.Translate(0, skinHeaderHeight*(1-scale))
+	if drawErr := fitted.DrawImage(skin, ops); drawErr != nil {
+		return nil, 0, drawErr
+	}
+
+	left := image.Rect(0, skinHeaderHeight, half, height)
+	ops.GeoM.Reset()
+	ops.SourceRect = &left
+	ops.GeoM.Translate(0, skinHeaderHeight)
+	if drawErr := fitted.DrawImage(skin, ops); drawErr != nil {
+		return nil, 0, drawErr
+	}
+
+	if width > skinWidth {
+		middle := image.Rect(half, skinHeaderHeight, half+1, height)
+		ops.GeoM.Reset()
+		ops.SourceRect = &middle
+		ops.GeoM.Scale(float64(width-skinWidth), 1)
+		ops.GeoM.Translate(float64(half), skinHeaderHeight)
+		if drawErr := fitted.DrawImage(skin, ops); drawErr != nil {
+			return nil, 0, drawErr
+		}
+	}
+
+	right := image.Rect(half, skinHeaderHeight, skinWidth, height)
+	ops.GeoM.Reset()
+	ops.SourceRect = &right
+	ops.GeoM.Translate(float64(width-(skinWidth-half)), skinHeaderHeight)
+	if drawErr := fitted.DrawImage(skin, ops); drawErr != nil {
+		return nil, 0, drawErr
+	}
+
+	return fitted, scale, nil
+}
@@ -0,0 +1,71 @@
+package spritetools
+
+import (
+	"github.com/hajimehoshi/ebiten"
+)
+
+// AnimationMode decides what an animation
+// shows once its last frame is done.
+type AnimationMode int
+
+const (
+	// Loop starts over from first frame.
+	Loop AnimationMode = iota
+	// Once keeps showing last frame.
+	Once
+)
+
+// Animation shows frames one after other, at a fixed rate.
+// It keeps no clock of its own, callers give time elapsed
+// since it started, so one animation can be shared by many
+// sprites.
+type Animation struct {
+	Frames []*ebiten.Image
+	// FPS is number of frames shown in a second.
+	FPS  float64
+	Mode AnimationMode
+}
+
+// NewAnimation returns an animation of given frames.
+func NewAnimation(frames []*ebiten.Image, fps float64, mode AnimationMode) *Animation {
+	return &Animation{
+		Frames: frames,
+		FPS:    fps,
+		Mode:   mode,
+	}
+}
+
+// Frame returns frame to show, given seconds since start.
+func (a *Animation) Frame(elapsed float64) *ebiten.Image {
+	i := int(elapsed * a.FPS)
+	if i < 0 {
+		i = 0
+	}
+	if a.Mode == Loop {
+		return a.Frames[i%len(a.Frames)]
+	}
+	if i >= len(a.Frames) {
+		i = len(a.Frames) - 1
+	}
+	return a.Frames[i]
+}
+
+// Done reports whether an animation shown once
+// is over, looping animations are never over.
+func (a *Animation) Done(elapsed float64) bool {
+	return a.Mode == Once && elapsed*a.FPS >= float64(len(a.Frames))
+}
+
+// Scale returns a copy of animation, with
+// every frame scaled by the given x & y.
+func (a *Animation) Scale(x, y float64) (*Animation, error) {
+	frames := make([]*ebiten.Image, len(a.Frames))
+	for i, frame := range a.Frames {
+		scaled, scaledErr := ScaleSprite(frame, x, y)
+		if scaledErr != nil {
+			return nil, scaledErr
+		}
+		frames[i] = scaled
+	}
+	return NewAnimation(frames, a.FPS, a.Mode), nil
+}
@@ -0,0 +1,40 @@
+package spritetools
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnimationFrame(t *testing.T) {
+	// frames are told apart by pointer, zero images are all equal.
+	frames := []*ebiten.Image{{}, {}, {}}
+	cases := []struct {
+		mode    AnimationMode
+		elapsed float64
+		frame   int
+		done    bool
+	}{
+		{Loop, -1, 0, false},
+		{Loop, 0, 0, false},
+		{Loop, 0.09, 0, false},
+		{Loop, 0.1, 1, false},
+		{Loop, 0.25, 2, false},
+		{Loop, 0.3, 0, false},
+		{Loop, 1.1, 2, false},
+		{Once, 0, 0, false},
+		{Once, 0.2, 2, false},
+		{Once, 0.3, 2, true},
+		{Once, 10, 2, true},
+		// callers keep the clock, going back to zero restarts it.
+		{Once, 0, 0, false},
+	}
+	for _, c := range cases {
+		animation := NewAnimation(frames, 10, c.mode)
+		assert.True(t, animation.Frame(c.elapsed) == frames[c.frame],
+			"Mode %d at %v seconds should show frame %d", c.mode, c.elapsed, c.frame)
+		assert.Equal(t, c.done, animation.Done(c.elapsed),
+			"Mode %d at %v seconds should be done: %v", c.mode, c.elapsed, c.done)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !js
+// +build !js
+
+package pacman
+
+import (
+	// linkname needs unsafe.
+	_ "unsafe"
+)
+
+// setWindowResizable lets player resize the window, by dragging its
+// edges. Ebiten v1.9 keeps it unexported till its specification is
+// settled, screen handed to update follows size of the window.
+//
+//go:linkname setWindowResizable github.com/hajimehoshi/ebiten.setWindowResizable
+func setWindowResizable(resizable bool)
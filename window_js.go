@@ -0,0 +1,8 @@
+//go:build js
+// +build js
+
+package pacman
+
+// setWindowResizable does nothing in browsers,
+// canvas is sized by the page.
+func setWindowResizable(resizable bool) {}